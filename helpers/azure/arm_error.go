@@ -0,0 +1,470 @@
+package azure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	headerCorrelationRequestID = "x-ms-correlation-request-id"
+
+	policyDeniedErrorCode = "RequestDisallowedByPolicy"
+)
+
+var (
+	armErrorOperationRegex  = regexp.MustCompile(`[A-Za-z0-9_.]+#[A-Za-z0-9_]+: |autorest/azure: Service returned an error`)
+	armErrorStatusCodeRegex = regexp.MustCompile(`Status(?:Code)?=(\d{3})`)
+	policyIdentifiersRegex  = regexp.MustCompile(`Policy identifiers: '(\[.*\])'`)
+)
+
+// ArmError is the structured error returned from Azure Resource Manager
+type ArmError struct {
+	// Context is the message the error was wrapped in, for example `creating Virtual Network ...`
+	Context string
+
+	StatusCode     int
+	Code           string
+	Message        string
+	Target         string
+	Details        []ArmError
+	AdditionalInfo []ArmErrorAdditionalInfo
+
+	CorrelationRequestID string
+	RequestID            string
+	RequestMethod        string
+	RequestURL           string
+
+	// RawError is the original error message, which the ARM error was parsed from
+	RawError string
+}
+
+// ArmErrorAdditionalInfo is additional information about an error, such as the Policy which was violated
+type ArmErrorAdditionalInfo struct {
+	Type string
+	Info map[string]interface{}
+}
+
+// PolicyViolation is a Policy Assignment which denied a request
+type PolicyViolation struct {
+	PolicyAssignmentID          string
+	PolicyAssignmentName        string
+	PolicyAssignmentDisplayName string
+	PolicyDefinitionID          string
+	PolicyDefinitionName        string
+	PolicyDefinitionDisplayName string
+	PolicyDefinitionEffect      string
+}
+
+// ParseArmError returns the structured ARM error contained within the error - either as a ServiceError within the
+// error chain, or (since errors are commonly wrapped using `%+v`) by parsing the error message
+func ParseArmError(err error) (*ArmError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	output := ArmError{}
+	var serviceError *azure.ServiceError
+	var detailedError autorest.DetailedError
+
+	var requestError *azure.RequestError
+	if errors.As(err, &requestError) && requestError.ServiceError != nil {
+		serviceError = requestError.ServiceError
+		detailedError = requestError.DetailedError
+		output.RequestID = requestError.RequestID
+	} else {
+		if !errors.As(err, &serviceError) {
+			serviceError = parseServiceError(err.Error())
+		}
+		errors.As(err, &detailedError)
+	}
+
+	if serviceError == nil {
+		return nil, false
+	}
+
+	output.Context = armErrorContext(err.Error())
+	output.RawError = err.Error()
+	output.Code = serviceError.Code
+	output.Message = serviceError.Message
+	if serviceError.Target != nil {
+		output.Target = *serviceError.Target
+	}
+	for _, v := range serviceError.Details {
+		output.Details = append(output.Details, armErrorFromMap(v))
+	}
+	output.AdditionalInfo = expandArmErrorAdditionalInfo(serviceError.AdditionalInfo)
+
+	if resp := detailedError.Response; resp != nil {
+		output.StatusCode = resp.StatusCode
+		output.CorrelationRequestID = resp.Header.Get(headerCorrelationRequestID)
+		if output.RequestID == "" {
+			output.RequestID = resp.Header.Get(azure.HeaderRequestID)
+		}
+		if req := resp.Request; req != nil {
+			if output.CorrelationRequestID == "" {
+				output.CorrelationRequestID = req.Header.Get(headerCorrelationRequestID)
+			}
+			output.RequestMethod = req.Method
+			if req.URL != nil {
+				output.RequestURL = req.URL.String()
+			}
+		}
+	}
+	if output.StatusCode == 0 {
+		if match := armErrorStatusCodeRegex.FindStringSubmatch(err.Error()); len(match) == 2 {
+			output.StatusCode, _ = strconv.Atoi(match[1])
+		}
+	}
+
+	return &output, true
+}
+
+// ErrorDiagnostics returns the Diagnostics for the error - where this contains an ARM error the Diagnostic contains
+// a readable summary with the details of the error, otherwise the error message is used as-is
+func ErrorDiagnostics(err error, correlationRequestID string) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	armError, ok := ParseArmError(err)
+	if !ok {
+		return diag.FromErr(err)
+	}
+
+	if armError.CorrelationRequestID == "" {
+		armError.CorrelationRequestID = correlationRequestID
+	}
+
+	return diag.Diagnostics{armError.Diagnostic()}
+}
+
+// Diagnostic returns a Diagnostic for this error, where requests which were denied by Azure Policy
+// include the Policy Assignment(s) which denied the request in the summary
+func (e ArmError) Diagnostic() diag.Diagnostic {
+	summary := e.Message
+	if violations := e.PolicyViolations(); len(violations) > 0 {
+		summary = fmt.Sprintf("the request was denied by the Policy Assignment %s", violations[0].assignment())
+		if len(violations) > 1 {
+			summary = fmt.Sprintf("%s (and %d other Policy Assignments)", summary, len(violations)-1)
+		}
+	}
+	if e.Code != "" {
+		summary = fmt.Sprintf("%s: %s", e.Code, summary)
+	}
+	if e.Context != "" {
+		summary = fmt.Sprintf("%s: %s", e.Context, summary)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   e.detail(),
+	}
+}
+
+// IsPolicyDenied returns whether the request was denied by Azure Policy
+func (e ArmError) IsPolicyDenied() bool {
+	return strings.EqualFold(e.Code, policyDeniedErrorCode) || len(e.PolicyViolations()) > 0
+}
+
+// PolicyViolations returns the Policy Assignments which denied the request, from the additional info
+// for this error (or the details within it) - or from the error message when this isn't available
+func (e ArmError) PolicyViolations() []PolicyViolation {
+	output := make([]PolicyViolation, 0)
+	seen := map[string]struct{}{}
+
+	var walk func(input ArmError)
+	walk = func(input ArmError) {
+		for _, v := range input.AdditionalInfo {
+			if !strings.EqualFold(v.Type, "PolicyViolation") {
+				continue
+			}
+			violation := PolicyViolation{
+				PolicyAssignmentID:          stringFromMap(v.Info, "policyAssignmentId"),
+				PolicyAssignmentName:        stringFromMap(v.Info, "policyAssignmentName"),
+				PolicyAssignmentDisplayName: stringFromMap(v.Info, "policyAssignmentDisplayName"),
+				PolicyDefinitionID:          stringFromMap(v.Info, "policyDefinitionId"),
+				PolicyDefinitionName:        stringFromMap(v.Info, "policyDefinitionName"),
+				PolicyDefinitionDisplayName: stringFromMap(v.Info, "policyDefinitionDisplayName"),
+				PolicyDefinitionEffect:      stringFromMap(v.Info, "policyDefinitionEffect"),
+			}
+			key := strings.ToLower(violation.PolicyAssignmentID + "|" + violation.PolicyDefinitionID)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			output = append(output, violation)
+		}
+
+		for _, v := range input.Details {
+			walk(v)
+		}
+	}
+	walk(e)
+
+	if len(output) == 0 && strings.EqualFold(e.Code, policyDeniedErrorCode) {
+		output = policyViolationsFromMessage(e.Message)
+	}
+
+	return output
+}
+
+func (e ArmError) detail() string {
+	lines := make([]string, 0)
+	if e.StatusCode != 0 {
+		lines = append(lines, fmt.Sprintf("Status Code: %d", e.StatusCode))
+	}
+	lines = append(lines, e.detailLines("")...)
+
+	if violations := e.PolicyViolations(); len(violations) > 0 {
+		lines = append(lines, "Policy Violations:")
+		for _, v := range violations {
+			lines = append(lines, fmt.Sprintf("  - Policy Assignment: %s", v.assignment()))
+			if definition := v.definition(); definition != "" {
+				lines = append(lines, fmt.Sprintf("    Policy Definition: %s", definition))
+			}
+			if v.PolicyDefinitionEffect != "" {
+				lines = append(lines, fmt.Sprintf("    Effect: %s", v.PolicyDefinitionEffect))
+			}
+		}
+	}
+
+	if e.RequestURL != "" {
+		lines = append(lines, fmt.Sprintf("Request: %s %s", e.RequestMethod, e.RequestURL))
+	}
+	if e.CorrelationRequestID != "" {
+		lines = append(lines, fmt.Sprintf("Correlation Request ID: %s", e.CorrelationRequestID))
+	}
+	if e.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("Request ID: %s", e.RequestID))
+	}
+	if e.RawError != "" {
+		lines = append(lines, fmt.Sprintf("Original Error: %s", e.RawError))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (e ArmError) detailLines(indent string) []string {
+	lines := []string{
+		fmt.Sprintf("%sCode: %s", indent, e.Code),
+		fmt.Sprintf("%sMessage: %s", indent, e.Message),
+	}
+	if e.Target != "" {
+		lines = append(lines, fmt.Sprintf("%sTarget: %s", indent, e.Target))
+	}
+
+	if len(e.AdditionalInfo) > 0 {
+		lines = append(lines, fmt.Sprintf("%sAdditional Info:", indent))
+		for _, v := range e.AdditionalInfo {
+			info, err := json.Marshal(v.Info)
+			if err != nil {
+				info = []byte(fmt.Sprintf("%+v", v.Info))
+			}
+			lines = append(lines, fmt.Sprintf("%s  - Type: %s", indent, v.Type))
+			lines = append(lines, fmt.Sprintf("%s    Info: %s", indent, info))
+		}
+	}
+
+	if len(e.Details) > 0 {
+		lines = append(lines, fmt.Sprintf("%sDetails:", indent))
+		for _, v := range e.Details {
+			nested := v.detailLines(indent + "    ")
+			nested[0] = fmt.Sprintf("%s  - %s", indent, strings.TrimPrefix(nested[0], indent+"    "))
+			lines = append(lines, nested...)
+		}
+	}
+
+	return lines
+}
+
+func (v PolicyViolation) assignment() string {
+	return describePolicyItem(v.PolicyAssignmentDisplayName, v.PolicyAssignmentName, v.PolicyAssignmentID)
+}
+
+func (v PolicyViolation) definition() string {
+	return describePolicyItem(v.PolicyDefinitionDisplayName, v.PolicyDefinitionName, v.PolicyDefinitionID)
+}
+
+func describePolicyItem(displayName, name, id string) string {
+	if displayName == "" {
+		displayName = name
+	}
+	if displayName == "" {
+		return id
+	}
+	if id == "" {
+		return fmt.Sprintf("%q", displayName)
+	}
+	return fmt.Sprintf("%q (%s)", displayName, id)
+}
+
+// policyViolationsFromMessage parses the Policy Assignments from the message of a `RequestDisallowedByPolicy`
+// error, which is in the format `... Policy identifiers: '[{"policyAssignment":{"name":"..","id":".."},...}]'.`
+func policyViolationsFromMessage(message string) []PolicyViolation {
+	match := policyIdentifiersRegex.FindStringSubmatch(message)
+	if len(match) != 2 {
+		return nil
+	}
+
+	var identifiers []struct {
+		PolicyAssignment struct {
+			Name string `json:"name"`
+			ID   string `json:"id"`
+		} `json:"policyAssignment"`
+		PolicyDefinition struct {
+			Name string `json:"name"`
+			ID   string `json:"id"`
+		} `json:"policyDefinition"`
+	}
+	if err := json.Unmarshal([]byte(match[1]), &identifiers); err != nil {
+		return nil
+	}
+
+	output := make([]PolicyViolation, 0, len(identifiers))
+	for _, v := range identifiers {
+		output = append(output, PolicyViolation{
+			PolicyAssignmentID:          v.PolicyAssignment.ID,
+			PolicyAssignmentDisplayName: v.PolicyAssignment.Name,
+			PolicyDefinitionID:          v.PolicyDefinition.ID,
+			PolicyDefinitionDisplayName: v.PolicyDefinition.Name,
+		})
+	}
+	return output
+}
+
+// armErrorContext returns the message the ARM error was wrapped in, which is everything prior to the
+// autorest operation (e.g. `network.VirtualNetworksClient#CreateOrUpdate: `), the autorest RequestError or the ARM error itself
+func armErrorContext(input string) string {
+	end := strings.Index(input, `Code="`)
+	if loc := armErrorOperationRegex.FindStringIndex(input); loc != nil && (end == -1 || loc[0] < end) {
+		end = loc[0]
+	}
+	if end == -1 {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(input[:end]), ":"))
+}
+
+// parseServiceError parses the ServiceError from an error message, which is in the format output by
+// `ServiceError.Error()`: `Code="..." Message="..." Target="..." Details=[...] InnerError={...} AdditionalInfo=[...]`
+func parseServiceError(input string) *azure.ServiceError {
+	start := strings.Index(input, `Code="`)
+	if start == -1 {
+		return nil
+	}
+
+	remaining := input[start+len("Code="):]
+	code, remaining, ok := unquotePrefix(remaining)
+	if !ok || !strings.HasPrefix(remaining, " Message=") {
+		return nil
+	}
+	message, remaining, ok := unquotePrefix(strings.TrimPrefix(remaining, " Message="))
+	if !ok {
+		return nil
+	}
+
+	output := azure.ServiceError{
+		Code:    code,
+		Message: message,
+	}
+
+	if strings.HasPrefix(remaining, " Target=") {
+		target, rest, ok := unquotePrefix(strings.TrimPrefix(remaining, " Target="))
+		if ok {
+			output.Target = &target
+			remaining = rest
+		}
+	}
+
+	if strings.HasPrefix(remaining, " Details=") {
+		remaining = decodeJSONPrefix(strings.TrimPrefix(remaining, " Details="), &output.Details)
+	}
+
+	if strings.HasPrefix(remaining, " InnerError=") {
+		remaining = decodeJSONPrefix(strings.TrimPrefix(remaining, " InnerError="), &output.InnerError)
+	}
+
+	if strings.HasPrefix(remaining, " AdditionalInfo=") {
+		decodeJSONPrefix(strings.TrimPrefix(remaining, " AdditionalInfo="), &output.AdditionalInfo)
+	}
+
+	return &output
+}
+
+func unquotePrefix(input string) (string, string, bool) {
+	quoted, err := strconv.QuotedPrefix(input)
+	if err != nil {
+		return "", input, false
+	}
+	value, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", input, false
+	}
+	return value, input[len(quoted):], true
+}
+
+// decodeJSONPrefix decodes the JSON value at the start of the input into the output, returning the remaining input
+func decodeJSONPrefix(input string, output interface{}) string {
+	decoder := json.NewDecoder(strings.NewReader(input))
+	if err := decoder.Decode(output); err != nil {
+		return input
+	}
+	return input[decoder.InputOffset():]
+}
+
+func armErrorFromMap(input map[string]interface{}) ArmError {
+	output := ArmError{
+		Code:    stringFromMap(input, "code"),
+		Message: stringFromMap(input, "message"),
+		Target:  stringFromMap(input, "target"),
+	}
+
+	if details, ok := input["details"].([]interface{}); ok {
+		for _, v := range details {
+			if detail, ok := v.(map[string]interface{}); ok {
+				output.Details = append(output.Details, armErrorFromMap(detail))
+			}
+		}
+	}
+
+	if additionalInfo, ok := input["additionalInfo"].([]interface{}); ok {
+		items := make([]map[string]interface{}, 0)
+		for _, v := range additionalInfo {
+			if item, ok := v.(map[string]interface{}); ok {
+				items = append(items, item)
+			}
+		}
+		output.AdditionalInfo = expandArmErrorAdditionalInfo(items)
+	}
+
+	return output
+}
+
+func expandArmErrorAdditionalInfo(input []map[string]interface{}) []ArmErrorAdditionalInfo {
+	output := make([]ArmErrorAdditionalInfo, 0)
+	for _, v := range input {
+		info, _ := v["info"].(map[string]interface{})
+		output = append(output, ArmErrorAdditionalInfo{
+			Type: stringFromMap(v, "type"),
+			Info: info,
+		})
+	}
+	return output
+}
+
+func stringFromMap(input map[string]interface{}, key string) string {
+	if v, ok := input[key].(string); ok {
+		return v
+	}
+	return ""
+}
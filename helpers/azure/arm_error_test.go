@@ -0,0 +1,194 @@
+package azure_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+const policyAssignmentId = "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/allowed-locations"
+
+func policyDeniedServiceError() *autorestAzure.ServiceError {
+	target := "network1"
+	return &autorestAzure.ServiceError{
+		Code:    "RequestDisallowedByPolicy",
+		Message: "Resource 'network1' was disallowed by policy.",
+		Target:  &target,
+		AdditionalInfo: []map[string]interface{}{
+			{
+				"type": "PolicyViolation",
+				"info": map[string]interface{}{
+					"policyAssignmentId":          policyAssignmentId,
+					"policyAssignmentName":        "allowed-locations",
+					"policyAssignmentDisplayName": "Allowed Locations",
+					"policyDefinitionId":          "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+					"policyDefinitionDisplayName": "Allowed locations",
+					"policyDefinitionEffect":      "deny",
+				},
+			},
+		},
+	}
+}
+
+func TestParseArmErrorFromRequestError(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1?api-version=2021-05-01", nil)
+	req.Header.Set("x-ms-correlation-request-id", "abc123")
+	resp := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{},
+		Request:    req,
+	}
+	resp.Header.Set("x-ms-request-id", "def456")
+
+	var err error = &autorestAzure.RequestError{
+		DetailedError: autorest.DetailedError{
+			PackageType: "network.VirtualNetworksClient",
+			Method:      "CreateOrUpdate",
+			StatusCode:  http.StatusForbidden,
+			Response:    resp,
+		},
+		ServiceError: policyDeniedServiceError(),
+	}
+	err = fmt.Errorf("creating Virtual Network %q: %w", "network1", err)
+
+	actual, ok := azure.ParseArmError(err)
+	if !ok {
+		t.Fatalf("expected an ARM error to be parsed from %+v", err)
+	}
+	if actual.Context != `creating Virtual Network "network1"` {
+		t.Fatalf("expected the context to be parsed but got %q", actual.Context)
+	}
+	if actual.StatusCode != http.StatusForbidden || actual.Code != "RequestDisallowedByPolicy" || actual.Target != "network1" {
+		t.Fatalf("expected the status code, code and target to be parsed but got %+v", actual)
+	}
+	if actual.CorrelationRequestID != "abc123" || actual.RequestID != "def456" {
+		t.Fatalf("expected the correlation/request ID's to be parsed but got %q / %q", actual.CorrelationRequestID, actual.RequestID)
+	}
+	if actual.RequestMethod != http.MethodPut || !strings.Contains(actual.RequestURL, "/virtualNetworks/network1") {
+		t.Fatalf("expected the request to be parsed but got %s %s", actual.RequestMethod, actual.RequestURL)
+	}
+	if !actual.IsPolicyDenied() {
+		t.Fatalf("expected the error to be policy denied")
+	}
+	if !strings.Contains(actual.RawError, `Code="RequestDisallowedByPolicy"`) {
+		t.Fatalf("expected the original error to be retained but got %q", actual.RawError)
+	}
+}
+
+func TestParseArmErrorFromMessage(t *testing.T) {
+	target := "resources"
+	serviceError := autorestAzure.ServiceError{
+		Code:    "InvalidTemplateDeployment",
+		Message: "The template deployment 'example' is not valid according to the validation procedure.",
+		Target:  &target,
+		Details: []map[string]interface{}{
+			{
+				"code":    "PrivateIPAddressInReservedRange",
+				"message": "Private static IP address 10.0.0.1 falls within reserved IP range of subnet prefix 10.0.0.0/24.",
+				"details": []interface{}{
+					map[string]interface{}{
+						"code":    "Nested",
+						"message": "a \"nested\" error",
+					},
+				},
+			},
+		},
+		InnerError: map[string]interface{}{
+			"error": "inner",
+		},
+	}
+	detailedError := autorest.NewErrorWithError(&autorestAzure.RequestError{ServiceError: &serviceError}, "resources.DeploymentsClient", "CreateOrUpdate", &http.Response{StatusCode: http.StatusBadRequest, Request: &http.Request{URL: &url.URL{}}}, "Failure sending request")
+
+	// errors are commonly wrapped using `%+v`, which loses the error chain
+	err := fmt.Errorf("creating Template Deployment %q: %+v", "example", detailedError)
+
+	actual, ok := azure.ParseArmError(err)
+	if !ok {
+		t.Fatalf("expected an ARM error to be parsed from %+v", err)
+	}
+	if actual.Context != `creating Template Deployment "example"` {
+		t.Fatalf("expected the context to be parsed but got %q", actual.Context)
+	}
+	if actual.StatusCode != http.StatusBadRequest || actual.Code != "InvalidTemplateDeployment" || actual.Message != serviceError.Message || actual.Target != "resources" {
+		t.Fatalf("expected the error to be parsed but got %+v", actual)
+	}
+	if len(actual.Details) != 1 || actual.Details[0].Code != "PrivateIPAddressInReservedRange" {
+		t.Fatalf("expected the details to be parsed but got %+v", actual.Details)
+	}
+	if len(actual.Details[0].Details) != 1 || actual.Details[0].Details[0].Message != `a "nested" error` {
+		t.Fatalf("expected the nested details to be parsed but got %+v", actual.Details[0].Details)
+	}
+	if actual.IsPolicyDenied() {
+		t.Fatalf("expected the error not to be policy denied")
+	}
+}
+
+func TestParseArmErrorPolicyDeniedFromMessage(t *testing.T) {
+	testData := []struct {
+		name  string
+		input error
+	}{
+		{
+			name:  "Additional Info",
+			input: fmt.Errorf("creating Virtual Network: %+v", policyDeniedServiceError()),
+		},
+		{
+			name:  "Policy Identifiers",
+			input: fmt.Errorf(`creating Virtual Network: Code="RequestDisallowedByPolicy" Message="Resource 'network1' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Allowed Locations\",\"id\":\"%s\"},\"policyDefinition\":{\"name\":\"Allowed locations\",\"id\":\"/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c\"}}]'."`, policyAssignmentId),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual, ok := azure.ParseArmError(v.input)
+		if !ok {
+			t.Fatalf("expected an ARM error to be parsed from %+v", v.input)
+		}
+
+		violations := actual.PolicyViolations()
+		if len(violations) != 1 || violations[0].PolicyAssignmentID != policyAssignmentId {
+			t.Fatalf("expected a single Policy Violation for %q but got %+v", policyAssignmentId, violations)
+		}
+
+		diagnostic := actual.Diagnostic()
+		expected := fmt.Sprintf(`creating Virtual Network: RequestDisallowedByPolicy: the request was denied by the Policy Assignment "Allowed Locations" (%s)`, policyAssignmentId)
+		if diagnostic.Summary != expected {
+			t.Fatalf("expected the summary to be %q but got %q", expected, diagnostic.Summary)
+		}
+	}
+}
+
+func TestErrorDiagnostics(t *testing.T) {
+	if actual := azure.ErrorDiagnostics(nil, ""); actual != nil {
+		t.Fatalf("expected no diagnostics but got %+v", actual)
+	}
+
+	actual := azure.ErrorDiagnostics(fmt.Errorf("something went wrong"), "abc123")
+	if len(actual) != 1 || actual[0].Severity != diag.Error || actual[0].Summary != "something went wrong" || actual[0].Detail != "" {
+		t.Fatalf("expected the error to be used as-is but got %+v", actual)
+	}
+
+	actual = azure.ErrorDiagnostics(fmt.Errorf(`retrieving Resource Group: resources.GroupsClient#Get: Failure responding to request: StatusCode=404 -- Original Error: autorest/azure: Service returned an error. Status=404 Code="ResourceGroupNotFound" Message="Resource group 'group1' could not be found."`), "abc123")
+	if len(actual) != 1 {
+		t.Fatalf("expected a single diagnostic but got %d", len(actual))
+	}
+	if expected := `retrieving Resource Group: ResourceGroupNotFound: Resource group 'group1' could not be found.`; actual[0].Summary != expected {
+		t.Fatalf("expected the summary to be %q but got %q", expected, actual[0].Summary)
+	}
+	expectedDetail := `Status Code: 404
+Code: ResourceGroupNotFound
+Message: Resource group 'group1' could not be found.
+Correlation Request ID: abc123
+Original Error: retrieving Resource Group: resources.GroupsClient#Get: Failure responding to request: StatusCode=404 -- Original Error: autorest/azure: Service returned an error. Status=404 Code="ResourceGroupNotFound" Message="Resource group 'group1' could not be found."`
+	if actual[0].Detail != expectedDetail {
+		t.Fatalf("expected the detail to be:\n\n%s\n\nbut got:\n\n%s", expectedDetail, actual[0].Detail)
+	}
+}
@@ -57,6 +57,9 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// random is the source of randomness for this test case when cassettes are in use, otherwise nil
+	random *rand.Rand
 }
 
 // BuildTestData generates some test data for the given resource
@@ -66,9 +69,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		t.Fatalf("Error retrieving Environment: %+v", err)
 	}
 
+	random := cassetteRandom(t)
 	testData := TestData{
-		RandomInteger:   RandTimeInt(),
-		RandomString:    randString(5),
+		RandomInteger:   randTimeIntFrom(random),
+		RandomString:    randStringFrom(random, 5),
 		ResourceName:    fmt.Sprintf("%s.%s", resourceType, resourceLabel),
 		Environment:     *env,
 		EnvironmentName: EnvironmentName(),
@@ -76,6 +80,7 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		random:        random,
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -131,20 +136,26 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
-	return randString(len)
+	return randStringFrom(td.random, len)
 }
 
-// randString generates a random alphanumeric string of the length specified
-func randString(strlen int) string {
-	return randStringFromCharSet(strlen, charSetAlphaNum)
+// randStringFrom generates a random alphanumeric string of the length specified, using
+// the source of randomness provided when set
+func randStringFrom(random *rand.Rand, strlen int) string {
+	return randStringFromCharSet(random, strlen, charSetAlphaNum)
 }
 
 // randStringFromCharSet generates a random string by selecting characters from
 // the charset provided
-func randStringFromCharSet(strlen int, charSet string) string {
+func randStringFromCharSet(random *rand.Rand, strlen int, charSet string) string {
+	intn := rand.Intn
+	if random != nil {
+		intn = random.Intn
+	}
+
 	result := make([]byte, strlen)
 	for i := 0; i < strlen; i++ {
-		result[i] = charSet[rand.Intn(len(charSet))]
+		result[i] = charSet[intn(len(charSet))]
 	}
 	return string(result)
 }
@@ -0,0 +1,594 @@
+package fakearm
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// EnvironmentName is the name of the Environment exposed by the Metadata endpoint of the Server,
+// which should be used as the `environment` alongside the `metadata_host` of the Server.
+const EnvironmentName = "fakearm"
+
+// ObjectId is the Object ID of the principal which the tokens issued by the Server belong to
+const ObjectId = "fa4ea4e0-0000-0000-0000-000000000000"
+
+// Server is a fake Azure Resource Manager which stores resources in memory, it exposes:
+//
+// * the Metadata endpoint (`/metadata/endpoints`), so that it can be used as the `metadata_host`
+// * a token endpoint which issues fake (unsigned) tokens
+// * Resource Provider registration
+// * Resource Groups and generic PUT/PATCH/GET/DELETE of resources, including listing child resources
+// * Long Running Operations, polled using the `Azure-AsyncOperation` and `Location` headers
+type Server struct {
+	// PollsUntilComplete is the number of times a Long Running Operation is polled before it completes
+	PollsUntilComplete int
+
+	// LongRunningOperations specifies whether PUT and DELETE requests should be completed asynchronously
+	LongRunningOperations bool
+
+	server *httptest.Server
+
+	lock       sync.Mutex
+	providers  map[string]map[string]string
+	resources  map[string]map[string]interface{}
+	operations map[string]*operation
+	requests   []string
+	nextId     int
+}
+
+type operation struct {
+	polls      int
+	resourceId string
+	deletion   bool
+}
+
+// NewServer starts a new fake Azure Resource Manager, which must be closed once it's no longer needed
+func NewServer() *Server {
+	s := &Server{
+		PollsUntilComplete:    1,
+		LongRunningOperations: true,
+		providers:             map[string]map[string]string{},
+		resources:             map[string]map[string]interface{}{},
+		operations:            map[string]*operation{},
+	}
+	s.server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Close shuts down the Server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Host returns the host (and port) of the Server, which can be used as the `metadata_host`
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+// URL returns the base URL of the Server, which is used as the Resource Manager endpoint
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Client returns an HTTP Client which trusts the certificate used by the Server
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// WriteCertificate writes the certificate used by the Server as a PEM file into the specified directory,
+// returning the path - which can be used as the `SSL_CERT_FILE` for processes which need to trust the Server
+func (s *Server) WriteCertificate(directory string) (string, error) {
+	cert := s.server.Certificate()
+	if cert == nil {
+		return "", fmt.Errorf("the server has no certificate")
+	}
+	return writeCertificate(directory, cert)
+}
+
+// Requests returns the method and path for each request received by the Server, in the order they were received
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	out := make([]string, len(s.requests))
+	copy(out, s.requests)
+	return out
+}
+
+// Resource returns the resource with the specified ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	v, ok := s.resources[normalizeId(id)]
+	return v, ok
+}
+
+// ProviderRegistrationState returns the registration state for the Resource Provider within the specified Subscription
+func (s *Server) ProviderRegistrationState(subscriptionId, namespace string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.providers[strings.ToLower(subscriptionId)]; ok {
+		if state, ok := v[strings.ToLower(namespace)]; ok {
+			return state
+		}
+	}
+	return "NotRegistered"
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	lowered := strings.ToLower(path)
+
+	switch {
+	case lowered == "/metadata/endpoints":
+		s.handleMetadata(w)
+	case strings.HasSuffix(lowered, "/oauth2/token") || strings.HasSuffix(lowered, "/oauth2/v2.0/token"):
+		s.handleToken(w)
+	case strings.HasPrefix(lowered, "/fakearm/operations/"):
+		s.handleOperation(w, segments[len(segments)-1])
+	case isSubscriptionRequest(segments):
+		s.handleSubscription(w, r, segments)
+	default:
+		s.handleResource(w, r, path)
+	}
+}
+
+func (s *Server) handleMetadata(w http.ResponseWriter) {
+	endpoint := s.server.URL + "/"
+	environments := []authentication.Environment{
+		{
+			Name:            EnvironmentName,
+			Portal:          endpoint,
+			ResourceManager: endpoint,
+			Graph:           endpoint,
+			GraphAudience:   endpoint,
+			Batch:           endpoint,
+			Gallery:         endpoint,
+			Authentication: authentication.Authentication{
+				LoginEndpoint:    endpoint,
+				Audiences:        []string{endpoint},
+				Tenant:           "common",
+				IdentityProvider: "AAD",
+			},
+			Suffixes: authentication.Suffixes{
+				KeyVaultDns:       "vault.fakearm.local",
+				Storage:           "fakearm.local",
+				SqlServerHostname: "database.fakearm.local",
+				AcrLoginServer:    "azurecr.fakearm.local",
+			},
+		},
+	}
+	writeJSON(w, http.StatusOK, environments)
+}
+
+func (s *Server) handleToken(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"token_type":     "Bearer",
+		"expires_in":     "3599",
+		"ext_expires_in": "3599",
+		"expires_on":     "4102444800",
+		"not_before":     "1577836800",
+		"resource":       s.server.URL + "/",
+		"access_token":   fakeAccessToken(),
+	})
+}
+
+// fakeAccessToken returns an unsigned JWT containing the claims which the Provider reads from access tokens
+func fakeAccessToken() string {
+	header, _ := json.Marshal(map[string]interface{}{
+		"alg": "none",
+		"typ": "JWT",
+	})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss": "fakearm",
+		"oid": ObjectId,
+		"exp": 4102444800,
+	})
+	return fmt.Sprintf("%s.%s.fakearm", base64.RawURLEncoding.EncodeToString(header), base64.RawURLEncoding.EncodeToString(claims))
+}
+
+// handleSubscription handles requests to the Subscription and the Resource Providers within it
+func (s *Server) handleSubscription(w http.ResponseWriter, r *http.Request, segments []string) {
+	subscriptionId := segments[1]
+	registrations, ok := s.providers[strings.ToLower(subscriptionId)]
+	if !ok {
+		registrations = map[string]string{}
+		s.providers[strings.ToLower(subscriptionId)] = registrations
+	}
+
+	// /subscriptions/{subscriptionId}
+	if len(segments) == 2 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s isn't supported for Subscriptions", r.Method))
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":             fmt.Sprintf("/subscriptions/%s", subscriptionId),
+			"subscriptionId": subscriptionId,
+			"displayName":    "Fake Subscription",
+			"state":          "Enabled",
+		})
+		return
+	}
+
+	// /subscriptions/{subscriptionId}/providers
+	if len(segments) == 3 {
+		namespaces := make([]string, 0)
+		for k := range registrations {
+			namespaces = append(namespaces, k)
+		}
+		sort.Strings(namespaces)
+		providers := make([]interface{}, 0)
+		for _, v := range namespaces {
+			providers = append(providers, providerResponse(subscriptionId, v, registrations[v]))
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": providers,
+		})
+		return
+	}
+
+	namespace := segments[3]
+	state, ok := registrations[strings.ToLower(namespace)]
+	if !ok {
+		state = "NotRegistered"
+	}
+
+	// /subscriptions/{subscriptionId}/providers/{namespace}/register
+	if len(segments) == 5 {
+		state = "Registered"
+		if strings.EqualFold(segments[4], "unregister") {
+			state = "Unregistered"
+		}
+		registrations[strings.ToLower(namespace)] = state
+	}
+
+	writeJSON(w, http.StatusOK, providerResponse(subscriptionId, namespace, state))
+}
+
+// handleResource handles the generic CRUD of resources (including Resource Groups) and listing child resources
+func (s *Server) handleResource(w http.ResponseWriter, r *http.Request, path string) {
+	id := normalizeId(path)
+	existing, exists := s.resources[id]
+
+	switch r.Method {
+	case http.MethodGet:
+		if exists {
+			writeJSON(w, http.StatusOK, existing)
+			return
+		}
+
+		if children := s.listChildren(id); children != nil {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"value": children,
+			})
+			return
+		}
+
+		writeNotFound(w, path)
+
+	case http.MethodHead:
+		if exists {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	case http.MethodPut, http.MethodPatch:
+		payload := map[string]interface{}{}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("parsing request body: %+v", err))
+				return
+			}
+		}
+
+		if !strings.Contains(id, "/resourcegroups/") || s.parentResourceGroupExists(id) {
+			if r.Method == http.MethodPatch {
+				if !exists {
+					writeNotFound(w, path)
+					return
+				}
+				payload = merge(existing, payload)
+			}
+			resource := s.buildResource(path, payload)
+			s.resources[id] = resource
+
+			status := http.StatusOK
+			if !exists {
+				status = http.StatusCreated
+			}
+			if s.LongRunningOperations && r.Method == http.MethodPut && !isResourceGroup(id) {
+				setProvisioningState(resource, "Creating")
+				s.startOperation(w, id, false)
+				writeJSON(w, status, resource)
+				return
+			}
+			setProvisioningState(resource, "Succeeded")
+			writeJSON(w, status, resource)
+			return
+		}
+
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("the Resource Group for %q was not found", path))
+
+	case http.MethodDelete:
+		if !exists {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if s.LongRunningOperations {
+			s.startOperation(w, id, true)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		s.deleteResource(id)
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s isn't supported", r.Method))
+	}
+}
+
+// handleOperation handles polling of a Long Running Operation
+func (s *Server) handleOperation(w http.ResponseWriter, operationId string) {
+	op, ok := s.operations[operationId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("operation %q was not found", operationId))
+		return
+	}
+
+	op.polls++
+	if op.polls < s.PollsUntilComplete {
+		w.Header().Set("Retry-After", "0")
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{
+			"id":     operationId,
+			"status": "InProgress",
+		})
+		return
+	}
+
+	if op.deletion {
+		s.deleteResource(op.resourceId)
+	} else if resource, ok := s.resources[op.resourceId]; ok {
+		setProvisioningState(resource, "Succeeded")
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":     operationId,
+		"status": "Succeeded",
+	})
+}
+
+func (s *Server) startOperation(w http.ResponseWriter, id string, deletion bool) {
+	s.nextId++
+	operationId := fmt.Sprintf("op-%d", s.nextId)
+	s.operations[operationId] = &operation{
+		resourceId: id,
+		deletion:   deletion,
+	}
+
+	uri := fmt.Sprintf("%s/fakearm/operations/%s", s.server.URL, operationId)
+	w.Header().Set("Azure-AsyncOperation", uri)
+	w.Header().Set("Location", uri)
+	w.Header().Set("Retry-After", "0")
+}
+
+func (s *Server) deleteResource(id string) {
+	delete(s.resources, id)
+
+	// deleting a parent resource (e.g. a Resource Group) also deletes the resources within it
+	for k := range s.resources {
+		if strings.HasPrefix(k, id+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+func (s *Server) listChildren(id string) []interface{} {
+	depth := strings.Count(id, "/") + 1
+	ids := make([]string, 0)
+	for k := range s.resources {
+		if strings.HasPrefix(k, id+"/") && strings.Count(k, "/") == depth {
+			ids = append(ids, k)
+		}
+	}
+
+	// a collection which doesn't exist is still a valid (empty) list when its parent exists
+	if len(ids) == 0 {
+		parent := id[:strings.LastIndex(id, "/")]
+		if _, ok := s.resources[parent]; !ok && !isSubscriptionScope(parent) {
+			return nil
+		}
+	}
+
+	sort.Strings(ids)
+	out := make([]interface{}, 0)
+	for _, v := range ids {
+		out = append(out, s.resources[v])
+	}
+	return out
+}
+
+func (s *Server) parentResourceGroupExists(id string) bool {
+	if isResourceGroup(id) {
+		return true
+	}
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments) < 4 {
+		return false
+	}
+	_, ok := s.resources["/"+strings.Join(segments[0:4], "/")]
+	return ok
+}
+
+func (s *Server) buildResource(path string, payload map[string]interface{}) map[string]interface{} {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	payload["id"] = path
+	payload["name"] = segments[len(segments)-1]
+	payload["type"] = resourceType(segments)
+	return payload
+}
+
+func providerResponse(subscriptionId, namespace, state string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                fmt.Sprintf("/subscriptions/%s/providers/%s", subscriptionId, namespace),
+		"namespace":         namespace,
+		"registrationState": state,
+		"resourceTypes":     []interface{}{},
+	}
+}
+
+// resourceType returns the ARM type for the resource at the specified path, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func resourceType(segments []string) string {
+	if len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups") {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !strings.EqualFold(segments[i], "providers") || i+1 >= len(segments) {
+			continue
+		}
+		namespace := segments[i+1]
+		types := make([]string, 0)
+		for j := i + 2; j < len(segments); j += 2 {
+			types = append(types, segments[j])
+		}
+		return strings.Join(append([]string{namespace}, types...), "/")
+	}
+
+	return ""
+}
+
+// isSubscriptionRequest returns whether the request is for the Subscription itself, or the (registration of)
+// Resource Providers within it - rather than a resource
+func isSubscriptionRequest(segments []string) bool {
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return false
+	}
+	if len(segments) == 2 {
+		return true
+	}
+	if !strings.EqualFold(segments[2], "providers") {
+		return false
+	}
+	switch len(segments) {
+	case 3, 4:
+		return true
+	case 5:
+		return strings.EqualFold(segments[4], "register") || strings.EqualFold(segments[4], "unregister")
+	}
+	return false
+}
+
+func isResourceGroup(id string) bool {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	return len(segments) == 4 && segments[0] == "subscriptions" && segments[2] == "resourcegroups"
+}
+
+func isSubscriptionScope(id string) bool {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	return len(segments) == 2 && segments[0] == "subscriptions"
+}
+
+func normalizeId(input string) string {
+	return strings.ToLower(strings.TrimSuffix(input, "/"))
+}
+
+func setProvisioningState(resource map[string]interface{}, state string) {
+	props, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		props = map[string]interface{}{}
+		resource["properties"] = props
+	}
+	props["provisioningState"] = state
+}
+
+// merge applies the (top-level and `properties`) values from the patch onto the existing resource
+func merge(existing, patch map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, v := range existing {
+		out[k] = v
+	}
+	for k, v := range patch {
+		if k == "properties" {
+			existingProps, _ := out[k].(map[string]interface{})
+			patchProps, ok := v.(map[string]interface{})
+			if ok && existingProps != nil {
+				merged := map[string]interface{}{}
+				for pk, pv := range existingProps {
+					merged[pk] = pv
+				}
+				for pk, pv := range patchProps {
+					merged[pk] = pv
+				}
+				out[k] = merged
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func writeNotFound(w http.ResponseWriter, path string) {
+	if isResourceGroup(normalizeId(path)) {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group %q could not be found.", path))
+		return
+	}
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", path))
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeCertificate(directory string, cert *x509.Certificate) (string, error) {
+	path := filepath.Join(directory, "fakearm.pem")
+	contents := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: cert.Raw,
+	})
+	if err := os.WriteFile(path, contents, 0o644); err != nil {
+		return "", fmt.Errorf("writing certificate to %q: %+v", path, err)
+	}
+	return path, nil
+}
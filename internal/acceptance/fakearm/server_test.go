@@ -0,0 +1,305 @@
+package fakearm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const subscriptionId = "12345678-1234-9876-4563-123456789012"
+
+func TestServerMetadata(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var environments []map[string]interface{}
+	if status := request(t, s, http.MethodGet, "/metadata/endpoints?api-version=2020-06-01", "", &environments); status != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", status)
+	}
+	if len(environments) != 1 {
+		t.Fatalf("expected 1 environment but got %d", len(environments))
+	}
+	if environments[0]["name"] != EnvironmentName {
+		t.Fatalf("expected the environment to be named %q but got %q", EnvironmentName, environments[0]["name"])
+	}
+	if environments[0]["resourceManager"] != s.URL()+"/" {
+		t.Fatalf("expected the Resource Manager endpoint to be %q but got %q", s.URL()+"/", environments[0]["resourceManager"])
+	}
+}
+
+func TestServerProviderRegistration(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	if state := s.ProviderRegistrationState(subscriptionId, "Microsoft.Network"); state != "NotRegistered" {
+		t.Fatalf("expected the provider to be NotRegistered but got %q", state)
+	}
+
+	uri := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/register?api-version=2016-02-01", subscriptionId)
+	if status := request(t, s, http.MethodPost, uri, "", nil); status != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", status)
+	}
+
+	var providers struct {
+		Value []struct {
+			Namespace         string `json:"namespace"`
+			RegistrationState string `json:"registrationState"`
+		} `json:"value"`
+	}
+	request(t, s, http.MethodGet, fmt.Sprintf("/subscriptions/%s/providers?api-version=2016-02-01", subscriptionId), "", &providers)
+	if len(providers.Value) != 1 || providers.Value[0].RegistrationState != "Registered" {
+		t.Fatalf("expected a single Registered provider but got %+v", providers.Value)
+	}
+}
+
+func TestServerResourceLifecycle(t *testing.T) {
+	s := NewServer()
+	s.PollsUntilComplete = 2
+	defer s.Close()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", subscriptionId)
+	networkId := groupId + "/providers/Microsoft.Network/virtualNetworks/network1"
+
+	if status := request(t, s, http.MethodPut, networkId, `{"location":"westeurope"}`, nil); status != http.StatusNotFound {
+		t.Fatalf("expected a 404 creating a resource in a missing Resource Group but got %d", status)
+	}
+
+	if status := request(t, s, http.MethodPut, groupId, `{"location":"westeurope"}`, nil); status != http.StatusCreated {
+		t.Fatalf("expected a 201 creating the Resource Group but got %d", status)
+	}
+
+	resp := rawRequest(t, s, http.MethodPut, networkId, `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`)
+	pollingUri := resp.Header.Get("Azure-AsyncOperation")
+	if pollingUri == "" || resp.Header.Get("Location") == "" {
+		t.Fatalf("expected the Azure-AsyncOperation and Location headers to be set")
+	}
+
+	expectedStatuses := []string{"InProgress", "Succeeded"}
+	for _, expected := range expectedStatuses {
+		var op map[string]interface{}
+		request(t, s, http.MethodGet, strings.TrimPrefix(pollingUri, s.URL()), "", &op)
+		if op["status"] != expected {
+			t.Fatalf("expected the operation status to be %q but got %q", expected, op["status"])
+		}
+	}
+
+	var network map[string]interface{}
+	request(t, s, http.MethodGet, networkId+"?api-version=2021-05-01", "", &network)
+	if network["type"] != "Microsoft.Network/virtualNetworks" {
+		t.Fatalf("expected the type to be `Microsoft.Network/virtualNetworks` but got %q", network["type"])
+	}
+	if state := network["properties"].(map[string]interface{})["provisioningState"]; state != "Succeeded" {
+		t.Fatalf("expected the provisioningState to be `Succeeded` but got %q", state)
+	}
+
+	var list struct {
+		Value []map[string]interface{} `json:"value"`
+	}
+	request(t, s, http.MethodGet, groupId+"/providers/Microsoft.Network/virtualNetworks", "", &list)
+	if len(list.Value) != 1 {
+		t.Fatalf("expected 1 Virtual Network in the list but got %d", len(list.Value))
+	}
+
+	s.LongRunningOperations = false
+	if status := request(t, s, http.MethodDelete, groupId, "", nil); status != http.StatusOK {
+		t.Fatalf("expected a 200 deleting the Resource Group but got %d", status)
+	}
+	if _, ok := s.Resource(networkId); ok {
+		t.Fatalf("expected the Virtual Network to be deleted alongside the Resource Group")
+	}
+	if status := request(t, s, http.MethodGet, networkId, "", nil); status != http.StatusNotFound {
+		t.Fatalf("expected a 404 retrieving a deleted resource but got %d", status)
+	}
+}
+
+func TestServerTypedResourceLifecycle(t *testing.T) {
+	s := NewServer()
+	s.LongRunningOperations = false
+	defer s.Close()
+
+	// the Provider's HTTP clients need to trust the certificate used by the Server, which must be configured prior
+	// to the first TLS connection using the system's certificates
+	certificatePath, err := s.WriteCertificate(t.TempDir())
+	if err != nil {
+		t.Fatalf("writing certificate: %+v", err)
+	}
+	t.Setenv("SSL_CERT_FILE", certificatePath)
+	t.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+
+	ctx := context.TODO()
+	tenantId := "00000000-0000-0000-0000-000000000000"
+	clientId := "11111111-1111-1111-1111-111111111111"
+	client, err := clients.Build(ctx, clients.ClientBuilder{
+		AuthConfig: &authentication.Config{
+			ClientID:                         clientId,
+			SubscriptionID:                   subscriptionId,
+			TenantID:                         tenantId,
+			Environment:                      EnvironmentName,
+			MetadataHost:                     s.Host(),
+			AuthenticatedAsAServicePrincipal: true,
+			AuthenticatedViaOIDC:             true,
+		},
+		Authorizer: clients.FederatedTokenAuthorizer{
+			ClientID: clientId,
+			TenantID: tenantId,
+			Token:    "federated-token",
+		},
+		SkipProviderRegistration: true,
+		TerraformVersion:         "1.0.0",
+		Features:                 features.Default(),
+	})
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	client.StopContext = ctx
+
+	if client.Account.ObjectId != ObjectId {
+		t.Fatalf("expected the Object ID to be read from the token issued by the Server but got %q", client.Account.ObjectId)
+	}
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", subscriptionId)
+	if status := request(t, s, http.MethodPut, groupId, `{"location":"westeurope"}`, nil); status != http.StatusCreated {
+		t.Fatalf("expected a 201 creating the Resource Group but got %d", status)
+	}
+
+	wrapper := sdk.NewResourceWrapper(applicationinsights.ApplicationInsightsWorkbookResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building resource: %+v", err)
+	}
+
+	config := func(displayName string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                "85b3e8bb-fc93-40be-83f2-98f6bec18ba0",
+			"resource_group_name": "example",
+			"location":            "westeurope",
+			"display_name":        displayName,
+			"data_json":           `{"version":"Notebook/1.0"}`,
+		})
+	}
+	apply := func(state *terraform.InstanceState, config *terraform.ResourceConfig) *terraform.InstanceState {
+		diff, err := resource.Diff(ctx, state, config, client)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+		newState, diags := resource.Apply(ctx, state, diff, client)
+		if diags.HasError() {
+			t.Fatalf("applying: %+v", diags)
+		}
+		return newState
+	}
+
+	// Create
+	state := apply(nil, config("first"))
+	workbookId := groupId + "/providers/Microsoft.Insights/workbooks/85b3e8bb-fc93-40be-83f2-98f6bec18ba0"
+	if !strings.EqualFold(state.ID, workbookId) {
+		t.Fatalf("expected the ID to be %q but got %q", workbookId, state.ID)
+	}
+	if state.Attributes["display_name"] != "first" {
+		t.Fatalf("expected the `display_name` to be read back as `first` but got %q", state.Attributes["display_name"])
+	}
+
+	// Update
+	state = apply(state, config("second"))
+	workbook, ok := s.Resource(workbookId)
+	if !ok {
+		t.Fatalf("expected the Workbook to exist on the Server")
+	}
+	if displayName := workbook["properties"].(map[string]interface{})["displayName"]; displayName != "second" {
+		t.Fatalf("expected the `displayName` to be updated on the Server but got %q", displayName)
+	}
+
+	// Read
+	refreshed, diags := resource.RefreshWithoutUpgrade(ctx, state, client)
+	if diags.HasError() {
+		t.Fatalf("refreshing: %+v", diags)
+	}
+	if refreshed == nil || refreshed.Attributes["display_name"] != "second" {
+		t.Fatalf("expected the `display_name` to be refreshed as `second` but got %+v", refreshed)
+	}
+
+	// Delete
+	deleted, diags := resource.Apply(ctx, refreshed, &terraform.InstanceDiff{Destroy: true}, client)
+	if diags.HasError() {
+		t.Fatalf("deleting: %+v", diags)
+	}
+	if deleted != nil && deleted.ID != "" {
+		t.Fatalf("expected the state to be removed but got %+v", deleted)
+	}
+	if _, ok := s.Resource(workbookId); ok {
+		t.Fatalf("expected the Workbook to be deleted from the Server")
+	}
+
+	if requests := s.Requests(); !utils.SliceContainsValue(requests, "GET /metadata/endpoints") {
+		t.Fatalf("expected the environment to be obtained from the Server but got %+v", requests)
+	}
+}
+
+func TestResourceType(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: "Microsoft.Resources/resourceGroups",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			expected: "Microsoft.Authorization/roleAssignments",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual := resourceType(strings.Split(strings.TrimPrefix(v.input, "/"), "/"))
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func request(t *testing.T, s *Server, method, uri, body string, out interface{}) int {
+	resp := rawRequest(t, s, method, uri, body)
+	defer resp.Body.Close()
+
+	if out != nil {
+		contents, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading response: %+v", err)
+		}
+		if err := json.Unmarshal(contents, out); err != nil {
+			t.Fatalf("parsing response %q: %+v", string(contents), err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func rawRequest(t *testing.T, s *Server, method, uri, body string) *http.Response {
+	req, err := http.NewRequestWithContext(context.TODO(), method, s.URL()+uri, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	return resp
+}
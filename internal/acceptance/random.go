@@ -1,11 +1,16 @@
 package acceptance
 
 import (
+	"hash/fnv"
+	"math/rand"
+	"os"
 	"strconv"
 	"strings"
+	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 func RandTimeInt() int {
@@ -26,6 +31,27 @@ func RandTimeInt() int {
 	return i
 }
 
+// cassetteRandom returns a source of randomness seeded from the name of the test when cassettes are
+// in use - so that the names of the resources match those within the recorded interactions - otherwise nil
+func cassetteRandom(t *testing.T) *rand.Rand {
+	if os.Getenv(common.CassetteModeEnvVar) == "" {
+		return nil
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(t.Name()))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// randTimeIntFrom returns an 18 digit integer from the source of randomness provided, or from RandTimeInt when nil
+func randTimeIntFrom(random *rand.Rand) int {
+	if random == nil {
+		return RandTimeInt()
+	}
+
+	return int(100000000000000000 + random.Int63n(900000000000000000))
+}
+
 // RandString generates a random alphanumeric string of the length specified
 func RandString(strlen int) string {
 	return acctest.RandString(strlen)
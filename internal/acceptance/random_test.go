@@ -1,6 +1,10 @@
 package acceptance
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
 
 func TestAccRandTimeInt(t *testing.T) {
 	t.Run("Rand Date int", func(t *testing.T) {
@@ -15,3 +19,26 @@ func TestAccRandTimeInt(t *testing.T) {
 		}
 	})
 }
+
+func TestBuildTestDataCassetteRandomness(t *testing.T) {
+	t.Setenv(common.CassetteModeEnvVar, common.CassetteModeReplay)
+
+	first := BuildTestData(t, "azurerm_resource_group", "test")
+	second := BuildTestData(t, "azurerm_resource_group", "test")
+	if first.RandomInteger != second.RandomInteger || first.RandomString != second.RandomString {
+		t.Fatalf("expected the random values to be seeded from the test name but got %d/%q and %d/%q", first.RandomInteger, first.RandomString, second.RandomInteger, second.RandomString)
+	}
+	if first.RandomStringOfLength(10) != second.RandomStringOfLength(10) {
+		t.Fatalf("expected RandomStringOfLength to be seeded from the test name")
+	}
+	if first.RandomInteger < 100000000000000000 || first.RandomInteger > 999999999999999999 {
+		t.Fatalf("expected an 18 digit RandomInteger but got %d", first.RandomInteger)
+	}
+
+	t.Run("Other", func(t *testing.T) {
+		other := BuildTestData(t, "azurerm_resource_group", "test")
+		if other.RandomInteger == first.RandomInteger {
+			t.Fatalf("expected a different test to use different random values")
+		}
+	})
+}
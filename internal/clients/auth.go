@@ -3,9 +3,12 @@ package clients
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
 )
 
 type ResourceManagerAccount struct {
@@ -18,7 +21,17 @@ type ResourceManagerAccount struct {
 	TenantId                         string
 }
 
-func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
+// Authorizer obtains tokens using an authentication method which isn't supported by go-azure-helpers - when
+// specified in the ClientBuilder this is used in place of the authentication method within the AuthConfig
+type Authorizer interface {
+	// Name returns the name of this authentication method, for logging purposes
+	Name() string
+
+	// TokenSource returns a source of tokens for the specified API within the specified Environment
+	TokenSource(ctx context.Context, environment environments.Environment, api environments.Api) (auth.Authorizer, error)
+}
+
+func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, skipResourceProviderRegistration bool, authorizer Authorizer) (*ResourceManagerAccount, error) {
 	objectId := ""
 
 	// TODO remove this when we confirm that MSI no longer returns nil with getAuthenticatedObjectID
@@ -28,6 +41,12 @@ func NewResourceManagerAccount(ctx context.Context, config authentication.Config
 			return nil, fmt.Errorf("getting authenticated object ID: %v", err)
 		}
 		objectId = *v
+	} else if authorizer != nil {
+		v, err := objectIdFromTokenClaims(ctx, config, env, authorizer)
+		if err != nil {
+			return nil, fmt.Errorf("getting authenticated object ID: %v", err)
+		}
+		objectId = *v
 	}
 
 	account := ResourceManagerAccount{
@@ -41,3 +60,66 @@ func NewResourceManagerAccount(ctx context.Context, config authentication.Config
 	}
 	return &account, nil
 }
+
+// objectIdFromTokenClaims returns the Object ID of the authenticated principal from the claims within a
+// token for Microsoft Graph, obtained using the specified Authorizer
+func objectIdFromTokenClaims(ctx context.Context, config authentication.Config, env azure.Environment, authorizer Authorizer) (*string, error) {
+	environment, err := hamiltonEnvironment(config.Environment, env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find environment %q: %+v", config.Environment, err)
+	}
+
+	source, err := authorizer.TokenSource(ctx, environment, environment.MsGraph)
+	if err != nil {
+		return nil, fmt.Errorf("building token source for Microsoft Graph: %+v", err)
+	}
+
+	token, err := source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("obtaining token for Microsoft Graph: %+v", err)
+	}
+	if token == nil || len(strings.Split(token.AccessToken, ".")) != 3 {
+		return nil, fmt.Errorf("the token for Microsoft Graph wasn't a JWT")
+	}
+
+	claims, err := auth.ParseClaims(token)
+	if err != nil {
+		return nil, fmt.Errorf("parsing claims from token: %+v", err)
+	}
+	if claims.ObjectId == "" {
+		return nil, fmt.Errorf("the token for Microsoft Graph didn't contain an Object ID")
+	}
+
+	return &claims.ObjectId, nil
+}
+
+// hamiltonEnvironment returns the Hamilton Environment with the specified name - falling back to the endpoints within
+// the Environment obtained from the Metadata Host for Environments which Hamilton doesn't know about (e.g. `fakearm`)
+func hamiltonEnvironment(name string, env azure.Environment) (environments.Environment, error) {
+	environment, err := environments.EnvironmentFromString(name)
+	if err == nil {
+		return environment, nil
+	}
+	if env.ResourceManagerEndpoint == "" || env.ActiveDirectoryEndpoint == "" {
+		return environments.Environment{}, err
+	}
+
+	apiFromEndpoint := func(endpoint string) environments.Api {
+		if endpoint == "" || endpoint == azure.NotAvailable {
+			return environments.ApiUnavailable
+		}
+		return environments.Api{
+			Endpoint: environments.ApiEndpoint(strings.TrimSuffix(endpoint, "/")),
+		}
+	}
+
+	return environments.Environment{
+		AzureADEndpoint: environments.AzureADEndpoint(strings.TrimSuffix(env.ActiveDirectoryEndpoint, "/")),
+		MsGraph:         apiFromEndpoint(env.GraphEndpoint),
+		ResourceManager: apiFromEndpoint(env.ResourceManagerEndpoint),
+		BatchManagement: apiFromEndpoint(env.BatchManagementEndpoint),
+		KeyVault:        apiFromEndpoint(env.KeyVaultEndpoint),
+		Storage:         environments.StoragePublic,
+		Synapse:         environments.ApiUnavailable,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+	"golang.org/x/oauth2"
+)
+
+var _ Authorizer = AzureCliTenantAuthorizer{}
+
+// AzureCliTenantAuthorizer obtains access tokens from the Azure CLI for the specified Tenant (and any Auxiliary
+// Tenants) - rather than the Tenant associated with the Subscription - which allows a user who's logged into
+// multiple Tenants to choose which Tenant should be used.
+type AzureCliTenantAuthorizer struct {
+	AuxiliaryTenantIDs []string
+	TenantID           string
+}
+
+func (a AzureCliTenantAuthorizer) Name() string {
+	return "Obtaining a token from the Azure CLI for a specific Tenant"
+}
+
+func (a AzureCliTenantAuthorizer) TokenSource(ctx context.Context, _ environments.Environment, api environments.Api) (auth.Authorizer, error) {
+	if a.TenantID == "" {
+		return nil, fmt.Errorf("a Tenant ID must be configured when obtaining a token from the Azure CLI for a specific Tenant")
+	}
+
+	return auth.NewCachedAuthorizer(&azureCliTenantTokenSource{
+		ctx:                ctx,
+		auxiliaryTenantIds: a.AuxiliaryTenantIDs,
+		resource:           strings.TrimSuffix(string(api.Endpoint), "/"),
+		tenantId:           a.TenantID,
+	}), nil
+}
+
+type azureCliTenantTokenSource struct {
+	ctx                context.Context
+	auxiliaryTenantIds []string
+	resource           string
+	tenantId           string
+}
+
+func (s *azureCliTenantTokenSource) Token() (*oauth2.Token, error) {
+	return obtainAzureCliToken(s.ctx, s.resource, s.tenantId)
+}
+
+func (s *azureCliTenantTokenSource) AuxiliaryTokens() ([]*oauth2.Token, error) {
+	tokens := make([]*oauth2.Token, 0)
+	for _, tenantId := range s.auxiliaryTenantIds {
+		token, err := obtainAzureCliToken(s.ctx, s.resource, tenantId)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// azureCliToken is the output of `az account get-access-token`
+type azureCliToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresOn   string `json:"expiresOn"`
+	Tenant      string `json:"tenant"`
+	TokenType   string `json:"tokenType"`
+
+	// ExpiresOnTimestamp is only returned by more recent versions of the Azure CLI
+	ExpiresOnTimestamp int64 `json:"expires_on"`
+}
+
+func obtainAzureCliToken(ctx context.Context, resource, tenantId string) (*oauth2.Token, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "az", "account", "get-access-token", "--resource", resource, "--tenant", tenantId, "--output", "json")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("obtaining a token for %q in Tenant %q from the Azure CLI: %+v\n\n%s", resource, tenantId, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var token azureCliToken
+	if err := json.Unmarshal(stdout.Bytes(), &token); err != nil {
+		return nil, fmt.Errorf("parsing the token for %q in Tenant %q from the Azure CLI: %+v", resource, tenantId, err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("the Azure CLI didn't return a token for %q in Tenant %q", resource, tenantId)
+	}
+
+	expiry, err := token.expiry()
+	if err != nil {
+		return nil, fmt.Errorf("parsing the expiry of the token for %q in Tenant %q from the Azure CLI: %+v", resource, tenantId, err)
+	}
+
+	tokenType := token.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   tokenType,
+		Expiry:      expiry,
+	}, nil
+}
+
+func (t azureCliToken) expiry() (time.Time, error) {
+	if t.ExpiresOnTimestamp > 0 {
+		return time.Unix(t.ExpiresOnTimestamp, 0), nil
+	}
+
+	// older versions of the Azure CLI return the expiry in the local timezone
+	return time.ParseInLocation("2006-01-02 15:04:05.999999", t.ExpiresOn, time.Local)
+}
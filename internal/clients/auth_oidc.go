@@ -0,0 +1,201 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+	"golang.org/x/oauth2"
+)
+
+var _ Authorizer = FederatedTokenAuthorizer{}
+
+// FederatedTokenAuthorizer authenticates as a Service Principal using OpenID Connect, by exchanging a federated
+// token for an access token. The federated token can either be specified directly or read from a file, such as
+// the file specified in the `AZURE_FEDERATED_TOKEN_FILE` Environment Variable when using AKS Workload Identity.
+type FederatedTokenAuthorizer struct {
+	AuxiliaryTenantIDs []string
+	ClientID           string
+	TenantID           string
+
+	// Token is the federated token (a JWT) which should be exchanged for an access token
+	Token string
+
+	// TokenFilePath is the path to a file containing the federated token, which is read again
+	// when the file changes, since these are rotated periodically
+	TokenFilePath string
+
+	// TokenURL optionally overrides the token endpoint, which is otherwise determined from
+	// the Environment and the Tenant ID
+	TokenURL string
+}
+
+func (a FederatedTokenAuthorizer) Name() string {
+	return "OIDC using a Federated Token"
+}
+
+// Validate confirms that the fields required to authenticate using a Federated Token are specified
+func (a FederatedTokenAuthorizer) Validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "a %s must be configured when authenticating with OIDC using a Federated Token"
+
+	if a.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+
+	if a.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+
+	if a.Token == "" && a.TokenFilePath == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Token or Token File Path"))
+	}
+
+	if a.Token != "" && a.TokenFilePath != "" {
+		err = multierror.Append(err, fmt.Errorf("only one of a Token or Token File Path can be configured when authenticating with OIDC using a Federated Token"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+func (a FederatedTokenAuthorizer) TokenSource(ctx context.Context, environment environments.Environment, api environments.Api) (auth.Authorizer, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &federatedTokenSource{
+		ctx:         ctx,
+		authorizer:  a,
+		environment: environment,
+		scopes:      []string{api.DefaultScope()},
+	}, nil
+}
+
+// federatedTokenSource caches the access tokens obtained using the federated token until either these expire
+// or the file containing the federated token changes, at which point new access tokens are obtained
+type federatedTokenSource struct {
+	ctx         context.Context
+	authorizer  FederatedTokenAuthorizer
+	environment environments.Environment
+	scopes      []string
+
+	mutex          sync.Mutex
+	fileModifiedAt time.Time
+	fileSize       int64
+	token          *oauth2.Token
+	auxTokens      []*oauth2.Token
+}
+
+func (s *federatedTokenSource) Token() (*oauth2.Token, error) {
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.token, nil
+}
+
+func (s *federatedTokenSource) AuxiliaryTokens() ([]*oauth2.Token, error) {
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.auxTokens, nil
+}
+
+// refresh obtains new access tokens when the cached tokens have expired or the token file has changed
+func (s *federatedTokenSource) refresh() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	valid := s.token != nil && s.token.Valid()
+	for _, token := range s.auxTokens {
+		valid = valid && token != nil && token.Valid()
+	}
+
+	var fileModifiedAt time.Time
+	var fileSize int64
+	if path := s.authorizer.TokenFilePath; path != "" {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("retrieving the Federated Token File %q: %+v", path, err)
+		}
+		fileModifiedAt = info.ModTime()
+		fileSize = info.Size()
+
+		if !fileModifiedAt.Equal(s.fileModifiedAt) || fileSize != s.fileSize {
+			if s.token != nil {
+				log.Printf("[DEBUG] The Federated Token File %q has changed - obtaining a new access token..", path)
+			}
+			valid = false
+		}
+	}
+
+	if valid {
+		return nil
+	}
+
+	assertion, err := s.assertion()
+	if err != nil {
+		return err
+	}
+
+	conf := auth.ClientCredentialsConfig{
+		Environment:        s.environment,
+		TenantID:           s.authorizer.TenantID,
+		AuxiliaryTenantIDs: s.authorizer.AuxiliaryTenantIDs,
+		ClientID:           s.authorizer.ClientID,
+		FederatedAssertion: assertion,
+		Scopes:             s.scopes,
+		TokenURL:           s.authorizer.TokenURL,
+		TokenVersion:       auth.TokenVersion2,
+	}
+	source := conf.TokenSource(s.ctx, auth.ClientCredentialsAssertionType)
+
+	token, err := source.Token()
+	if err != nil {
+		return fmt.Errorf("exchanging the Federated Token for an access token: %+v", err)
+	}
+
+	auxTokens, err := source.AuxiliaryTokens()
+	if err != nil {
+		return fmt.Errorf("exchanging the Federated Token for access tokens for the Auxiliary Tenants: %+v", err)
+	}
+
+	s.token = token
+	s.auxTokens = auxTokens
+	s.fileModifiedAt = fileModifiedAt
+	s.fileSize = fileSize
+	return nil
+}
+
+// assertion returns the federated token, reading this from the token file when specified
+func (s *federatedTokenSource) assertion() (string, error) {
+	path := s.authorizer.TokenFilePath
+	if path == "" {
+		return s.authorizer.Token, nil
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading the Federated Token File %q: %+v", path, err)
+	}
+
+	assertion := strings.TrimSpace(string(contents))
+	if assertion == "" {
+		return "", fmt.Errorf("the Federated Token File %q was empty", path)
+	}
+
+	return assertion, nil
+}
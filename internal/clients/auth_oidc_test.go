@@ -0,0 +1,218 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/environments"
+)
+
+// fakeTokenEndpoint records the federated tokens (client assertions) which are exchanged for access tokens
+type fakeTokenEndpoint struct {
+	mutex      sync.Mutex
+	assertions []string
+}
+
+func (f *fakeTokenEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if r.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" || r.PostForm.Get("client_id") != "11111111-1111-1111-1111-111111111111" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	f.mutex.Lock()
+	f.assertions = append(f.assertions, r.PostForm.Get("client_assertion"))
+	count := len(f.assertions)
+	f.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": fmt.Sprintf("access-token-%d", count),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (f *fakeTokenEndpoint) exchanged() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string{}, f.assertions...)
+}
+
+func TestFederatedTokenAuthorizerValidate(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Authorizer FederatedTokenAuthorizer
+		Valid      bool
+	}{
+		{
+			Name: "Token",
+			Authorizer: FederatedTokenAuthorizer{
+				ClientID: "11111111-1111-1111-1111-111111111111",
+				TenantID: "00000000-0000-0000-0000-000000000000",
+				Token:    "federated-token",
+			},
+			Valid: true,
+		},
+		{
+			Name: "Token File Path",
+			Authorizer: FederatedTokenAuthorizer{
+				ClientID:      "11111111-1111-1111-1111-111111111111",
+				TenantID:      "00000000-0000-0000-0000-000000000000",
+				TokenFilePath: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+			Valid: true,
+		},
+		{
+			Name: "No Token",
+			Authorizer: FederatedTokenAuthorizer{
+				ClientID: "11111111-1111-1111-1111-111111111111",
+				TenantID: "00000000-0000-0000-0000-000000000000",
+			},
+			Valid: false,
+		},
+		{
+			Name: "Token and Token File Path",
+			Authorizer: FederatedTokenAuthorizer{
+				ClientID:      "11111111-1111-1111-1111-111111111111",
+				TenantID:      "00000000-0000-0000-0000-000000000000",
+				Token:         "federated-token",
+				TokenFilePath: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+			Valid: false,
+		},
+		{
+			Name: "No Client ID",
+			Authorizer: FederatedTokenAuthorizer{
+				TenantID: "00000000-0000-0000-0000-000000000000",
+				Token:    "federated-token",
+			},
+			Valid: false,
+		},
+		{
+			Name: "No Tenant ID",
+			Authorizer: FederatedTokenAuthorizer{
+				ClientID: "11111111-1111-1111-1111-111111111111",
+				Token:    "federated-token",
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := v.Authorizer.Validate()
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestFederatedTokenAuthorizerToken(t *testing.T) {
+	endpoint := &fakeTokenEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	authorizer := FederatedTokenAuthorizer{
+		ClientID: "11111111-1111-1111-1111-111111111111",
+		TenantID: "00000000-0000-0000-0000-000000000000",
+		Token:    "federated-token",
+		TokenURL: server.URL,
+	}
+	source, err := authorizer.TokenSource(context.TODO(), environments.Global, environments.Global.ResourceManager)
+	if err != nil {
+		t.Fatalf("building token source: %+v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token()
+		if err != nil {
+			t.Fatalf("obtaining token: %+v", err)
+		}
+		if token.AccessToken != "access-token-1" {
+			t.Fatalf("expected the access token `access-token-1` but got %q", token.AccessToken)
+		}
+	}
+
+	if exchanged := endpoint.exchanged(); len(exchanged) != 1 || exchanged[0] != "federated-token" {
+		t.Fatalf("expected the federated token to be exchanged once but got %+v", exchanged)
+	}
+}
+
+func TestFederatedTokenAuthorizerTokenFileRotation(t *testing.T) {
+	endpoint := &fakeTokenEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "azure-identity-token")
+	if err := os.WriteFile(path, []byte("first-token\n"), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+
+	authorizer := FederatedTokenAuthorizer{
+		ClientID:      "11111111-1111-1111-1111-111111111111",
+		TenantID:      "00000000-0000-0000-0000-000000000000",
+		TokenFilePath: path,
+		TokenURL:      server.URL,
+	}
+	source, err := authorizer.TokenSource(context.TODO(), environments.Global, environments.Global.ResourceManager)
+	if err != nil {
+		t.Fatalf("building token source: %+v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token()
+		if err != nil {
+			t.Fatalf("obtaining token: %+v", err)
+		}
+		if token.AccessToken != "access-token-1" {
+			t.Fatalf("expected the access token `access-token-1` but got %q", token.AccessToken)
+		}
+	}
+
+	// rotate the token, as would happen when the projected service account token is renewed
+	if err := os.WriteFile(path, []byte("second-token\n"), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+	modifiedAt := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, modifiedAt, modifiedAt); err != nil {
+		t.Fatalf("updating the modification time of the token file: %+v", err)
+	}
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("obtaining token: %+v", err)
+	}
+	if token.AccessToken != "access-token-2" {
+		t.Fatalf("expected the access token `access-token-2` but got %q", token.AccessToken)
+	}
+
+	exchanged := endpoint.exchanged()
+	if len(exchanged) != 2 || exchanged[0] != "first-token" || exchanged[1] != "second-token" {
+		t.Fatalf("expected the federated tokens `first-token` and `second-token` to be exchanged but got %+v", exchanged)
+	}
+
+	// an empty token file should be surfaced as an error
+	if err := os.WriteFile(path, []byte(""), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+	if _, err := source.Token(); err == nil {
+		t.Fatalf("expected an error for an empty token file but didn't get one")
+	}
+}
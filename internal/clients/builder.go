@@ -9,19 +9,28 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	authWrapper "github.com/manicminer/hamilton-autorest/auth"
 	"github.com/manicminer/hamilton/environments"
 )
 
 type ClientBuilder struct {
-	AuthConfig                  *authentication.Config
+	AuthConfig *authentication.Config
+
+	// Authorizer optionally overrides the authentication method within the AuthConfig, for authentication
+	// methods which aren't supported by go-azure-helpers (e.g. OIDC using a federated token file)
+	Authorizer Authorizer
+
+	CacheReadRequests           bool
 	DisableCorrelationRequestID bool
 	CustomCorrelationRequestID  string
 	DisableTerraformPartnerID   bool
+	LogFormat                   string
 	PartnerId                   string
+	RateLimitReadsPerSecond     int
+	RateLimitWritesPerSecond    int
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
@@ -58,13 +67,13 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	// Hamilton environment configuration
-	environment, err := environments.EnvironmentFromString(builder.AuthConfig.Environment)
+	environment, err := hamiltonEnvironment(builder.AuthConfig.Environment, *env)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
 
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration, builder.Authorizer)
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
@@ -83,24 +92,40 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to configure OAuthConfig for tenant %s", builder.AuthConfig.TenantID)
 	}
 
-	sender := sender.BuildSender("AzureRM")
+	sender := common.BuildSender(builder.AuthConfig.SubscriptionID, builder.AuthConfig.TenantID)
 
 	var auth, storageAuth, synapseAuth, batchManagementAuth autorest.Authorizer
 	var keyVaultAuth *autorest.BearerAuthorizerCallback
 	var tokenFunc common.EndpointTokenFunc
 
-	auth, err = builder.AuthConfig.GetMSALToken(ctx, environment.ResourceManager, sender, oauthConfig, string(environment.ResourceManager.Endpoint))
+	// getAuthorizer returns an Authorizer for the specified API, using the Authorizer from the builder when specified
+	getAuthorizer := func(api environments.Api) (autorest.Authorizer, error) {
+		if builder.Authorizer != nil {
+			source, err := builder.Authorizer.TokenSource(ctx, environment, api)
+			if err != nil {
+				return nil, err
+			}
+			return &authWrapper.Authorizer{Authorizer: source}, nil
+		}
+
+		return builder.AuthConfig.GetMSALToken(ctx, api, sender, oauthConfig, string(api.Endpoint))
+	}
+	if builder.Authorizer != nil {
+		log.Printf("[DEBUG] Using %s for Authentication", builder.Authorizer.Name())
+	}
+
+	auth, err = getAuthorizer(environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get MSAL authorization token for resource manager API: %+v", err)
 	}
 
-	storageAuth, err = builder.AuthConfig.GetMSALToken(ctx, environment.Storage, sender, oauthConfig, string(environment.Storage.Endpoint))
+	storageAuth, err = getAuthorizer(environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get MSAL authorization token for storage API: %+v", err)
 	}
 
 	if environment.Synapse.IsAvailable() {
-		synapseAuth, err = builder.AuthConfig.GetMSALToken(ctx, environment.Synapse, sender, oauthConfig, string(environment.Synapse.Endpoint))
+		synapseAuth, err = getAuthorizer(environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get MSAL authorization token for synapse API: %+v", err)
 		}
@@ -108,17 +133,25 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		log.Printf("[DEBUG] Skipping building the Synapse MSAL Authorizer since this is not supported in the current Azure Environment")
 	}
 
-	batchManagementAuth, err = builder.AuthConfig.GetMSALToken(ctx, environment.BatchManagement, sender, oauthConfig, string(environment.BatchManagement.Endpoint))
+	batchManagementAuth, err = getAuthorizer(environment.BatchManagement)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get MSAL authorization token for batch management API: %+v", err)
 	}
 
-	keyVaultAuth = builder.AuthConfig.MSALBearerAuthorizerCallback(ctx, environment.KeyVault, sender, oauthConfig, string(environment.KeyVault.Endpoint))
+	if builder.Authorizer != nil {
+		source, err := builder.Authorizer.TokenSource(ctx, environment, environment.KeyVault)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get MSAL authorization token for key vault API: %+v", err)
+		}
+		keyVaultAuth = (&authWrapper.Authorizer{Authorizer: source}).BearerAuthorizerCallback()
+	} else {
+		keyVaultAuth = builder.AuthConfig.MSALBearerAuthorizerCallback(ctx, environment.KeyVault, sender, oauthConfig, string(environment.KeyVault.Endpoint))
+	}
 
 	// Helper for obtaining endpoint-specific tokens
 	tokenFunc = func(endpoint string) (autorest.Authorizer, error) {
 		api := environments.Api{Endpoint: environments.ApiEndpoint(endpoint)}
-		authorizer, err := builder.AuthConfig.GetMSALToken(ctx, api, sender, oauthConfig, endpoint)
+		authorizer, err := getAuthorizer(api)
 		if err != nil {
 			return nil, fmt.Errorf("getting MSAL authorization token for endpoint %s: %+v", endpoint, err)
 		}
@@ -142,17 +175,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		Environment:                 *env,
 		Features:                    builder.Features,
+		LogFormat:                   builder.LogFormat,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		RateLimiter:                 common.NewRateLimiter(builder.RateLimitReadsPerSecond, builder.RateLimitWritesPerSecond),
 		TokenFunc:                   tokenFunc,
 	}
 
+	if builder.CacheReadRequests {
+		o.ReadCache = common.NewReadCache(env.ResourceManagerEndpoint)
+	}
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
 	if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, env.ResourceManagerEndpoint)
-		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient, env.Name)
 	}
 
 	return &client, nil
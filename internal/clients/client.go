@@ -2,6 +2,9 @@ package clients
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
@@ -119,6 +122,19 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// CorrelationRequestID is the value sent in the `x-ms-correlation-request-id` header, if enabled
+	CorrelationRequestID string
+
+	// LogFormat is the format used for log messages from the Typed SDK, either `text` or `json`
+	LogFormat string
+
+	// options are retained so that Clients for other Subscriptions can be built using the same credentials
+	options *common.ClientOptions
+
+	// subscriptionClients caches the Clients built for other Subscriptions, keyed by the Subscription ID
+	subscriptionClients     map[string]*Client
+	subscriptionClientsLock *sync.Mutex
+
 	AadB2c                *aadb2c.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
@@ -233,6 +249,11 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.CorrelationRequestID = o.CorrelationRequestID()
+	client.LogFormat = o.LogFormat
+	client.options = o
+	client.subscriptionClients = make(map[string]*Client)
+	client.subscriptionClientsLock = &sync.Mutex{}
 
 	client.AadB2c = aadb2c.NewClient(o)
 	client.Advisor = advisor.NewClient(o)
@@ -340,3 +361,44 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	return nil
 }
+
+// ForSubscription returns a Client for managing resources within the specified Subscription, which uses the same
+// credentials, features and rate limits as this Client. Clients for other Subscriptions are built on first use and
+// then cached - when the Subscription ID is empty or matches the Subscription of this Client, this Client is returned.
+func (client *Client) ForSubscription(subscriptionId string) (*Client, error) {
+	if subscriptionId == "" || client.Account == nil || strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return client, nil
+	}
+	if client.options == nil {
+		return nil, fmt.Errorf("building a Client for Subscription %q: the Client hasn't been built", subscriptionId)
+	}
+
+	key := strings.ToLower(subscriptionId)
+	client.subscriptionClientsLock.Lock()
+	defer client.subscriptionClientsLock.Unlock()
+
+	if existing, ok := client.subscriptionClients[key]; ok {
+		return existing, nil
+	}
+
+	o := *client.options
+	o.SubscriptionId = subscriptionId
+
+	account := *client.Account
+	account.SubscriptionId = subscriptionId
+
+	subscriptionClient := Client{
+		Account: &account,
+	}
+	if err := subscriptionClient.Build(client.StopContext, &o); err != nil {
+		return nil, fmt.Errorf("building Client for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	// Clients for other Subscriptions share the cache of this Client, so that these aren't built more than once
+	subscriptionClient.subscriptionClients = client.subscriptionClients
+	subscriptionClient.subscriptionClientsLock = client.subscriptionClientsLock
+	subscriptionClient.subscriptionClients[strings.ToLower(client.Account.SubscriptionId)] = client
+
+	client.subscriptionClients[key] = &subscriptionClient
+	return &subscriptionClient, nil
+}
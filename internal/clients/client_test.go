@@ -0,0 +1,70 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestClientForSubscription(t *testing.T) {
+	client := Client{
+		Account: &ResourceManagerAccount{
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			TenantId:       "11111111-1111-1111-1111-111111111111",
+		},
+	}
+	o := &common.ClientOptions{
+		SubscriptionId:          "00000000-0000-0000-0000-000000000000",
+		TenantID:                "11111111-1111-1111-1111-111111111111",
+		ResourceManagerEndpoint: azure.PublicCloud.ResourceManagerEndpoint,
+		Environment:             azure.PublicCloud,
+	}
+	if err := client.Build(context.TODO(), o); err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	for _, subscriptionId := range []string{"", "00000000-0000-0000-0000-000000000000"} {
+		actual, err := client.ForSubscription(subscriptionId)
+		if err != nil {
+			t.Fatalf("retrieving client for %q: %+v", subscriptionId, err)
+		}
+		if actual != &client {
+			t.Fatalf("expected the client for %q to be the provider client", subscriptionId)
+		}
+	}
+
+	other, err := client.ForSubscription("22222222-2222-2222-2222-222222222222")
+	if err != nil {
+		t.Fatalf("retrieving client for another subscription: %+v", err)
+	}
+	if other == &client {
+		t.Fatalf("expected a new client to be built for another subscription")
+	}
+	if other.Account.SubscriptionId != "22222222-2222-2222-2222-222222222222" || other.Account.TenantId != client.Account.TenantId {
+		t.Fatalf("expected the account to be for the other subscription but got %+v", other.Account)
+	}
+	if other.Network.VnetPeeringsClient.SubscriptionID != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("expected the service clients to be for the other subscription but got %q", other.Network.VnetPeeringsClient.SubscriptionID)
+	}
+	if client.Account.SubscriptionId != "00000000-0000-0000-0000-000000000000" || client.Network.VnetPeeringsClient.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("expected the provider client to be unchanged")
+	}
+
+	cached, err := client.ForSubscription("22222222-2222-2222-2222-222222222222")
+	if err != nil {
+		t.Fatalf("retrieving cached client for another subscription: %+v", err)
+	}
+	if cached != other {
+		t.Fatalf("expected the client for another subscription to be cached")
+	}
+
+	original, err := other.ForSubscription("00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("retrieving client for the provider subscription: %+v", err)
+	}
+	if original != &client {
+		t.Fatalf("expected the client for the provider subscription to be the provider client")
+	}
+}
@@ -0,0 +1,306 @@
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// CassetteModeEnvVar is the environment variable used to select the cassette mode, either `record` or `replay`.
+	CassetteModeEnvVar = "ARM_TEST_CASSETTE_MODE"
+
+	// CassetteDirEnvVar is the environment variable used to specify the directory where cassettes are stored.
+	CassetteDirEnvVar = "ARM_TEST_CASSETTE_DIR"
+
+	CassetteModeRecord = "record"
+	CassetteModeReplay = "replay"
+
+	// scrubbedID replaces Subscription and Tenant IDs within recorded interactions
+	scrubbedID = "00000000-0000-0000-0000-000000000000"
+
+	scrubbedValue = "REDACTED"
+)
+
+var (
+	cassettesLock sync.Mutex
+	cassettes     = map[string]*cassette{}
+
+	scrubbedHeaders = []string{
+		"Authorization",
+		"Set-Cookie",
+		"X-Ms-Authorization-Auxiliary",
+	}
+
+	scrubJSONTokensRegex   = regexp.MustCompile(`"(access_token|refresh_token|id_token|client_secret)"\s*:\s*"[^"]*"`)
+	scrubFormTokensRegex   = regexp.MustCompile(`(access_token|refresh_token|client_secret|client_assertion|sig)=[^&"\s]*`)
+	scrubSubscriptionRegex = regexp.MustCompile(`(?i)/subscriptions/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+)
+
+// cassetteInteraction is a single recorded request/response pair
+type cassetteInteraction struct {
+	Method          string              `json:"method"`
+	URL             string              `json:"url"`
+	RequestBody     string              `json:"request_body,omitempty"`
+	StatusCode      int                 `json:"status_code"`
+	ResponseHeaders map[string][]string `json:"response_headers,omitempty"`
+	ResponseBody    string              `json:"response_body,omitempty"`
+}
+
+// cassette records or replays HTTP interactions stored on disk, keyed by the (scrubbed) request method, URL and body
+type cassette struct {
+	mode              string
+	directory         string
+	sensitiveIDs      []string
+	sensitiveIDsRegex []*regexp.Regexp
+
+	lock         sync.Mutex
+	interactions map[string][]cassetteInteraction
+	replayIndex  map[string]int
+}
+
+// cassetteFromEnvironment returns the cassette configured via the environment, or nil when cassettes aren't in use
+func cassetteFromEnvironment(sensitiveIDs ...string) *cassette {
+	mode := strings.ToLower(os.Getenv(CassetteModeEnvVar))
+	if mode != CassetteModeRecord && mode != CassetteModeReplay {
+		if mode != "" {
+			log.Printf("[WARN] Ignoring unsupported value %q for %s - expected %q or %q", mode, CassetteModeEnvVar, CassetteModeRecord, CassetteModeReplay)
+		}
+		return nil
+	}
+
+	directory := os.Getenv(CassetteDirEnvVar)
+	if directory == "" {
+		directory = "testdata/cassettes"
+	}
+
+	cassettesLock.Lock()
+	defer cassettesLock.Unlock()
+
+	key := fmt.Sprintf("%s|%s", mode, directory)
+	if existing, ok := cassettes[key]; ok {
+		existing.addSensitiveIDs(sensitiveIDs...)
+		return existing
+	}
+
+	c := newCassette(mode, directory, sensitiveIDs...)
+	cassettes[key] = c
+	return c
+}
+
+func newCassette(mode, directory string, sensitiveIDs ...string) *cassette {
+	c := &cassette{
+		mode:         mode,
+		directory:    directory,
+		interactions: map[string][]cassetteInteraction{},
+		replayIndex:  map[string]int{},
+	}
+	c.addSensitiveIDs(sensitiveIDs...)
+	return c
+}
+
+func (c *cassette) addSensitiveIDs(ids ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, id := range ids {
+		if id == "" || id == scrubbedID {
+			continue
+		}
+		found := false
+		for _, existing := range c.sensitiveIDs {
+			if strings.EqualFold(existing, id) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.sensitiveIDs = append(c.sensitiveIDs, id)
+			c.sensitiveIDsRegex = append(c.sensitiveIDsRegex, regexp.MustCompile(`(?i)`+regexp.QuoteMeta(id)))
+		}
+	}
+}
+
+// scrub removes tokens, secrets and Subscription/Tenant IDs from the specified value, the lock must be held by the caller
+func (c *cassette) scrub(input string) string {
+	output := scrubJSONTokensRegex.ReplaceAllString(input, fmt.Sprintf(`"$1":"%s"`, scrubbedValue))
+	output = scrubFormTokensRegex.ReplaceAllString(output, fmt.Sprintf("$1=%s", scrubbedValue))
+	output = scrubSubscriptionRegex.ReplaceAllString(output, fmt.Sprintf("/subscriptions/%s", scrubbedID))
+	for _, r := range c.sensitiveIDsRegex {
+		output = r.ReplaceAllString(output, scrubbedID)
+	}
+	return output
+}
+
+// wrap returns a Sender which records the interactions performed by the specified Sender, or replays previously
+// recorded interactions without calling it at all
+func (c *cassette) wrap(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		requestBody, err := readAndRestoreRequestBody(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body for cassette: %+v", err)
+		}
+
+		c.lock.Lock()
+		method := r.Method
+		uri := c.scrub(r.URL.String())
+		body := c.scrub(requestBody)
+		c.lock.Unlock()
+
+		key := cassetteKey(method, uri, body)
+
+		if c.mode == CassetteModeReplay {
+			return c.replay(r, key)
+		}
+
+		resp, err := s.Do(r)
+		if err != nil || resp == nil {
+			return resp, err
+		}
+
+		responseBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading response body for cassette: %+v", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+		if err := c.record(key, method, uri, body, resp, string(responseBody)); err != nil {
+			log.Printf("[WARN] Unable to record interaction for %s %s: %+v", method, uri, err)
+		}
+
+		return resp, nil
+	})
+}
+
+func (c *cassette) record(key, method, uri, body string, resp *http.Response, responseBody string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	headers := map[string][]string{}
+	for k, v := range resp.Header {
+		if isScrubbedHeader(k) {
+			continue
+		}
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, c.scrub(item))
+		}
+		headers[k] = values
+	}
+
+	c.interactions[key] = append(c.interactions[key], cassetteInteraction{
+		Method:          method,
+		URL:             uri,
+		RequestBody:     body,
+		StatusCode:      resp.StatusCode,
+		ResponseHeaders: headers,
+		ResponseBody:    c.scrub(responseBody),
+	})
+
+	if err := os.MkdirAll(c.directory, 0o755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", c.directory, err)
+	}
+
+	contents, err := json.MarshalIndent(c.interactions[key], "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing interactions: %+v", err)
+	}
+
+	return os.WriteFile(c.fileName(key), contents, 0o644)
+}
+
+func (c *cassette) replay(r *http.Request, key string) (*http.Response, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	interactions, ok := c.interactions[key]
+	if !ok {
+		contents, err := os.ReadFile(c.fileName(key))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("no recorded interaction was found for %s %s", r.Method, c.scrub(r.URL.String()))
+			}
+			return nil, fmt.Errorf("reading cassette %q: %+v", c.fileName(key), err)
+		}
+		if err := json.Unmarshal(contents, &interactions); err != nil {
+			return nil, fmt.Errorf("deserializing cassette %q: %+v", c.fileName(key), err)
+		}
+		if len(interactions) == 0 {
+			return nil, fmt.Errorf("cassette %q contains no interactions", c.fileName(key))
+		}
+		c.interactions[key] = interactions
+	}
+
+	// identical requests (e.g. polling) are replayed in the order they were recorded, the last response is then
+	// returned for any subsequent requests
+	index := c.replayIndex[key]
+	if index >= len(interactions) {
+		index = len(interactions) - 1
+	}
+	c.replayIndex[key] = index + 1
+	interaction := interactions[index]
+
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(strings.NewReader(interaction.ResponseBody)),
+		ContentLength: int64(len(interaction.ResponseBody)),
+		Request:       r,
+	}
+	for k, v := range interaction.ResponseHeaders {
+		for _, item := range v {
+			resp.Header.Add(k, item)
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *cassette) fileName(key string) string {
+	return filepath.Join(c.directory, fmt.Sprintf("%s.json", key))
+}
+
+func cassetteKey(method, uri, body string) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{strings.ToUpper(method), uri, body}, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+func isScrubbedHeader(name string) bool {
+	for _, v := range scrubbedHeaders {
+		if strings.EqualFold(v, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func readAndRestoreRequestBody(r *http.Request) (string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
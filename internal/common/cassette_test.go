@@ -0,0 +1,89 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	subscriptionId := "12345678-1234-9876-4563-123456789012"
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Set-Cookie", "secret")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"/subscriptions/` + subscriptionId + `/resourceGroups/example","access_token":"abc123"}`))
+	}))
+	defer server.Close()
+
+	directory := t.TempDir()
+	uri := server.URL + "/subscriptions/" + subscriptionId + "/resourceGroups/example?api-version=2020-01-01"
+
+	recorder := newCassette(CassetteModeRecord, directory, subscriptionId)
+	resp, err := send(recorder.wrap(&http.Client{}), uri)
+	if err != nil {
+		t.Fatalf("recording: %+v", err)
+	}
+	if !strings.Contains(resp, subscriptionId) {
+		t.Fatalf("expected the live response to be returned unmodified but got %q", resp)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(directory, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 cassette file but got %d", len(files))
+	}
+	contents, _ := os.ReadFile(files[0])
+	for _, v := range []string{subscriptionId, "abc123", "secret"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected %q to be scrubbed from the cassette but got %s", v, contents)
+		}
+	}
+
+	replayer := newCassette(CassetteModeReplay, directory, subscriptionId)
+	resp, err = send(replayer.wrap(&http.Client{}), uri)
+	if err != nil {
+		t.Fatalf("replaying: %+v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected the server to be called once but got %d", calls)
+	}
+	if !strings.Contains(resp, scrubbedID) {
+		t.Fatalf("expected the replayed response to contain the scrubbed ID but got %q", resp)
+	}
+
+	if _, err := send(replayer.wrap(&http.Client{}), server.URL+"/other"); err == nil {
+		t.Fatalf("expected an error for a request which wasn't recorded")
+	}
+}
+
+func TestCassetteKeyIncludesBody(t *testing.T) {
+	first := cassetteKey("PUT", "https://example.com/resource", `{"a":1}`)
+	second := cassetteKey("PUT", "https://example.com/resource", `{"a":2}`)
+	if first == second {
+		t.Fatalf("expected keys for different request bodies to differ")
+	}
+	if cassetteKey("put", "https://example.com/resource", `{"a":1}`) != first {
+		t.Fatalf("expected the key to be case-insensitive for the request method")
+	}
+}
+
+func send(s autorest.Sender, uri string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
@@ -34,8 +34,15 @@ type ClientOptions struct {
 	DisableTerraformPartnerID   bool
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
+	LogFormat                   string
 	StorageUseAzureAD           bool
 
+	// RateLimiter is shared between every client within the Provider, so that requests are limited as a whole
+	RateLimiter *RateLimiter
+
+	// ReadCache is shared between every client within the Provider, when enabled
+	ReadCache *ReadCache
+
 	// Some Dataplane APIs require a token scoped for a specific endpoint
 	TokenFunc EndpointTokenFunc
 
@@ -48,17 +55,51 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = o.Sender()
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.CorrelationRequestID(); id != "" {
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
 }
 
+// CorrelationRequestID returns the value sent in the `x-ms-correlation-request-id` header,
+// or an empty string when this has been disabled
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
+}
+
+// Sender returns the Sender used by clients within the Provider, which is rate limited when a RateLimiter is configured
+// and serves cached responses when a ReadCache is configured
+func (o ClientOptions) Sender() autorest.Sender {
+	s := BuildSender(o.SubscriptionId, o.TenantID)
+	if o.RateLimiter != nil {
+		s = o.RateLimiter.Wrap(s)
+	}
+	// cached responses shouldn't count towards the rate limit
+	if o.ReadCache != nil {
+		s = o.ReadCache.Wrap(s)
+	}
+	return s
+}
+
+// BuildSender returns the Sender used to send requests to Azure - which records or replays interactions when
+// a cassette has been configured using the `ARM_TEST_CASSETTE_MODE` environment variable.
+func BuildSender(sensitiveIDs ...string) autorest.Sender {
+	s := sender.BuildSender("AzureRM")
+	if cassette := cassetteFromEnvironment(sensitiveIDs...); cassette != nil {
+		return cassette.wrap(s)
+	}
+	return s
+}
+
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", tfVersion, meta.SDKVersionString())
 
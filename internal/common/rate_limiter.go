@@ -0,0 +1,255 @@
+package common
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	headerRemainingSubscriptionReads  = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRemainingSubscriptionWrites = "x-ms-ratelimit-remaining-subscription-writes"
+	headerRetryAfter                  = "Retry-After"
+
+	// headerRefillReadsPerSecond and headerRefillWritesPerSecond are the rates at which Resource Manager refills
+	// the number of requests remaining for a Subscription, which are used to back off when no rate is configured
+	headerRefillReadsPerSecond  = 25
+	headerRefillWritesPerSecond = 10
+)
+
+// RateLimiter limits the rate of requests sent to Azure using a token bucket for each of reads and writes,
+// which is shared between every client within the Provider.
+//
+// The number of tokens available is capped to the number of requests which Resource Manager reports as remaining
+// (via the `x-ms-ratelimit-remaining-subscription-*` headers) and when a request is throttled, all subsequent
+// requests to the same host are paused until the duration specified in the `Retry-After` header has elapsed. When no
+// rate is configured, requests are only delayed once the number of requests remaining runs low.
+type RateLimiter struct {
+	reads  *tokenBucket
+	writes *tokenBucket
+
+	lock         sync.Mutex
+	blockedUntil map[string]time.Time
+
+	// now and sleep are overridden in tests
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// NewRateLimiter returns a RateLimiter allowing the specified number of reads and writes per second, where
+// zero means the number of requests isn't limited (other than when throttled by Azure)
+func NewRateLimiter(readsPerSecond, writesPerSecond int) *RateLimiter {
+	return &RateLimiter{
+		reads:        newTokenBucket(readsPerSecond, headerRefillReadsPerSecond),
+		writes:       newTokenBucket(writesPerSecond, headerRefillWritesPerSecond),
+		blockedUntil: map[string]time.Time{},
+		now:          time.Now,
+		sleep:        sleepWithContext,
+	}
+}
+
+// Wrap returns a Sender which waits for the RateLimiter prior to sending each request
+func (l *RateLimiter) Wrap(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if err := l.wait(r); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.Do(r)
+		if resp != nil {
+			l.update(r, resp)
+		}
+		return resp, err
+	})
+}
+
+func (l *RateLimiter) wait(r *http.Request) error {
+	for {
+		delay := l.retryAfterDelay(r.URL.Host)
+		if delay <= 0 {
+			delay = l.bucketFor(r.Method).take(l.now())
+		}
+		if delay <= 0 {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Rate Limiting: waiting %s before sending %s %s", delay, r.Method, r.URL.Path)
+		if err := l.sleep(r.Context(), delay); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepWithContext waits for the specified duration, returning early if the context is cancelled
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+func (l *RateLimiter) update(r *http.Request, resp *http.Response) {
+	header := headerRemainingSubscriptionWrites
+	if isReadRequest(r.Method) {
+		header = headerRemainingSubscriptionReads
+	}
+	if v := resp.Header.Get(header); v != "" {
+		if remaining, err := strconv.Atoi(v); err == nil {
+			l.bucketFor(r.Method).capTo(float64(remaining), l.now())
+		}
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return
+	}
+	delay := parseRetryAfter(resp.Header.Get(headerRetryAfter), l.now())
+	if delay <= 0 {
+		return
+	}
+
+	log.Printf("[DEBUG] Rate Limiting: requests to %q were throttled - pausing requests for %s", r.URL.Host, delay)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if until := l.now().Add(delay); until.After(l.blockedUntil[r.URL.Host]) {
+		l.blockedUntil[r.URL.Host] = until
+	}
+}
+
+func (l *RateLimiter) retryAfterDelay(host string) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	until, ok := l.blockedUntil[host]
+	if !ok {
+		return 0
+	}
+	delay := until.Sub(l.now())
+	if delay <= 0 {
+		delete(l.blockedUntil, host)
+	}
+	return delay
+}
+
+func (l *RateLimiter) bucketFor(method string) *tokenBucket {
+	if isReadRequest(method) {
+		return l.reads
+	}
+	return l.writes
+}
+
+func isReadRequest(method string) bool {
+	return strings.EqualFold(method, http.MethodGet) || strings.EqualFold(method, http.MethodHead)
+}
+
+// parseRetryAfter parses the value of the `Retry-After` header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(input string, now time.Time) time.Duration {
+	if input == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(input); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(input); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// tokenBucket is a token bucket which refills at a fixed rate per second, up to a capacity of one second's worth of tokens.
+//
+// A bucket without a rate doesn't limit requests until it's capped to the number of requests remaining, at which point
+// it refills at the headerRate until a full second's worth of tokens is available again.
+type tokenBucket struct {
+	lock       sync.Mutex
+	rate       float64
+	headerRate float64
+	capped     bool
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(perSecond, headerRefillPerSecond int) *tokenBucket {
+	return &tokenBucket{
+		rate:       float64(perSecond),
+		headerRate: float64(headerRefillPerSecond),
+		tokens:     float64(perSecond),
+	}
+}
+
+// limited returns whether requests are currently limited by this bucket, the lock must be held by the caller
+func (b *tokenBucket) limited() bool {
+	return b.rate > 0 || b.capped
+}
+
+// refillRate returns the rate at which the bucket refills, the lock must be held by the caller
+func (b *tokenBucket) refillRate() float64 {
+	if b.rate > 0 {
+		return b.rate
+	}
+	return b.headerRate
+}
+
+// take removes a token from the bucket, returning zero if one was available or otherwise the duration to wait
+// before trying again
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.limited() {
+		return 0
+	}
+
+	b.refill(now)
+	if !b.limited() {
+		return 0
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - b.tokens) / b.refillRate() * float64(time.Second)))
+}
+
+// capTo limits the number of tokens available to the number of requests remaining
+func (b *tokenBucket) capTo(remaining float64, now time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.limited() {
+		// without a rate, only start limiting requests once fewer than a second's worth remain
+		if remaining >= b.headerRate {
+			return
+		}
+		b.capped = true
+		b.tokens = remaining
+		b.lastRefill = now
+		return
+	}
+
+	b.refill(now)
+	if remaining < b.tokens {
+		b.tokens = remaining
+	}
+}
+
+// refill adds the tokens accrued since the last refill, the lock must be held by the caller
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.lastRefill.IsZero() {
+		rate := b.refillRate()
+		b.tokens = math.Min(rate, b.tokens+now.Sub(b.lastRefill).Seconds()*rate)
+	}
+	b.lastRefill = now
+
+	if b.capped && b.tokens >= b.headerRate {
+		b.capped = false
+	}
+}
@@ -0,0 +1,204 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type fakeClock struct {
+	now    time.Time
+	slept  time.Duration
+	sleeps int
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.now = c.now.Add(d)
+	c.slept += d
+	c.sleeps++
+	return nil
+}
+
+func testRateLimiter(reads, writes int) (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{
+		now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	limiter := NewRateLimiter(reads, writes)
+	limiter.now = clock.Now
+	limiter.sleep = clock.Sleep
+	return limiter, clock
+}
+
+func respondWith(statusCode int, headers map[string]string) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		resp := &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{},
+			Request:    r,
+		}
+		for k, v := range headers {
+			resp.Header.Set(k, v)
+		}
+		return resp, nil
+	})
+}
+
+func sendRequests(t *testing.T, s autorest.Sender, method string, count int) {
+	for i := 0; i < count; i++ {
+		req, _ := http.NewRequest(method, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+		if _, err := s.Do(req); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter, clock := testRateLimiter(0, 0)
+	sendRequests(t, limiter.Wrap(respondWith(http.StatusOK, nil)), http.MethodGet, 100)
+
+	if clock.sleeps != 0 {
+		t.Fatalf("expected no requests to be delayed but got %d", clock.sleeps)
+	}
+}
+
+func TestRateLimiterLimitsReadsAndWritesSeparately(t *testing.T) {
+	limiter, clock := testRateLimiter(10, 1)
+	s := limiter.Wrap(respondWith(http.StatusOK, nil))
+
+	sendRequests(t, s, http.MethodGet, 10)
+	if clock.sleeps != 0 {
+		t.Fatalf("expected the initial burst of reads not to be delayed but got %d", clock.sleeps)
+	}
+
+	sendRequests(t, s, http.MethodGet, 10)
+	if clock.slept < 900*time.Millisecond || clock.slept > 1100*time.Millisecond {
+		t.Fatalf("expected the second burst of reads to take ~1s but took %s", clock.slept)
+	}
+
+	clock.slept = 0
+	sendRequests(t, s, http.MethodPut, 3)
+	if clock.slept < 1900*time.Millisecond || clock.slept > 2100*time.Millisecond {
+		t.Fatalf("expected 3 writes to take ~2s but took %s", clock.slept)
+	}
+}
+
+func TestRateLimiterCapsToRemainingRequests(t *testing.T) {
+	limiter, clock := testRateLimiter(10, 10)
+	s := limiter.Wrap(respondWith(http.StatusOK, map[string]string{
+		headerRemainingSubscriptionReads: strconv.Itoa(0),
+	}))
+
+	sendRequests(t, s, http.MethodGet, 2)
+	if clock.sleeps == 0 {
+		t.Fatalf("expected the second read to be delayed once no requests remain")
+	}
+
+	// writes are tracked separately
+	clock.sleeps = 0
+	sendRequests(t, s, http.MethodPut, 5)
+	if clock.sleeps != 0 {
+		t.Fatalf("expected writes not to be delayed but got %d", clock.sleeps)
+	}
+}
+
+func TestRateLimiterUnlimitedBacksOffWhenNoRequestsRemain(t *testing.T) {
+	limiter, clock := testRateLimiter(0, 0)
+
+	sendRequests(t, limiter.Wrap(respondWith(http.StatusOK, map[string]string{
+		headerRemainingSubscriptionReads: strconv.Itoa(11999),
+	})), http.MethodGet, 10)
+	if clock.sleeps != 0 {
+		t.Fatalf("expected reads not to be delayed whilst requests remain but got %d", clock.sleeps)
+	}
+
+	sendRequests(t, limiter.Wrap(respondWith(http.StatusOK, map[string]string{
+		headerRemainingSubscriptionReads: strconv.Itoa(0),
+	})), http.MethodGet, 2)
+	if clock.sleeps == 0 {
+		t.Fatalf("expected the second read to be delayed once no requests remain")
+	}
+
+	// once the bucket has refilled, requests are no longer limited
+	clock.now = clock.now.Add(time.Minute)
+	clock.sleeps = 0
+	sendRequests(t, limiter.Wrap(respondWith(http.StatusOK, nil)), http.MethodGet, 100)
+	if clock.sleeps != 0 {
+		t.Fatalf("expected reads not to be delayed once refilled but got %d", clock.sleeps)
+	}
+}
+
+func TestRateLimiterWaitIsCancelledByContext(t *testing.T) {
+	limiter := NewRateLimiter(0, 0)
+	limiter.lock.Lock()
+	limiter.blockedUntil["management.azure.com"] = time.Now().Add(time.Hour)
+	limiter.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+
+	start := time.Now()
+	if _, err := limiter.Wrap(respondWith(http.StatusOK, nil)).Do(req); err == nil {
+		t.Fatalf("expected an error when the context is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the wait to be cancelled with the context but took %s", elapsed)
+	}
+}
+
+func TestRateLimiterHonoursRetryAfter(t *testing.T) {
+	limiter, clock := testRateLimiter(0, 0)
+
+	sendRequests(t, limiter.Wrap(respondWith(http.StatusTooManyRequests, map[string]string{
+		headerRetryAfter: "17",
+	})), http.MethodPut, 1)
+	if clock.sleeps != 0 {
+		t.Fatalf("expected the throttled request not to be delayed")
+	}
+
+	sendRequests(t, limiter.Wrap(respondWith(http.StatusOK, nil)), http.MethodGet, 2)
+	if clock.slept != 17*time.Second {
+		t.Fatalf("expected subsequent requests to be delayed by 17s but got %s", clock.slept)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testData := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{
+			input:    "",
+			expected: 0,
+		},
+		{
+			input:    "30",
+			expected: 30 * time.Second,
+		},
+		{
+			input:    "Fri, 01 Jan 2021 00:01:00 GMT",
+			expected: time.Minute,
+		},
+		{
+			input:    "invalid",
+			expected: 0,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		if actual := parseRetryAfter(v.input, now); actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type cachedReadsKey struct{}
+
+// WithCachedReads returns a context for which GET requests can be served from the ReadCache (when enabled) - this
+// is used for Read operations (for example during a plan or refresh) where parent resources are retrieved repeatedly
+func WithCachedReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cachedReadsKey{}, true)
+}
+
+// WithCachedReadsOutsideApply returns a context for which GET requests can be served from the ReadCache, unless the
+// resource is being read during an apply (for example after it's been created or updated) - in which case the
+// resource is always retrieved from Azure
+func WithCachedReadsOutsideApply(ctx context.Context, d *schema.ResourceData) context.Context {
+	if d.IsNewResource() || d.HasChangesExcept() {
+		return ctx
+	}
+
+	return WithCachedReads(ctx)
+}
+
+func cachedReadsAllowed(ctx context.Context) bool {
+	v, ok := ctx.Value(cachedReadsKey{}).(bool)
+	return ok && v
+}
+
+// maxReadCacheSize is the total size of the response bodies held in a ReadCache, once this is reached further
+// responses are no longer cached until existing entries have been invalidated
+const maxReadCacheSize = 64 * 1024 * 1024
+
+// readCacheKeyHeaders are the request headers which change the response returned for a URL
+var readCacheKeyHeaders = []string{
+	"Accept",
+	"Range",
+	"x-ms-range",
+}
+
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// ReadCache caches the JSON responses to GET requests sent to Resource Manager from a context created using
+// WithCachedReads, keyed on the URL (including the api-version). Cached responses are invalidated by any PUT, PATCH,
+// POST or DELETE request to a URL sharing the same Resource ID prefix - for example a PUT to a Subnet invalidates
+// both the Subnet and the Virtual Network containing it.
+//
+// Requests to other hosts (such as the Storage and Key Vault data plane APIs, where names are case-sensitive and
+// the response bodies can be large) are never cached.
+//
+// A ReadCache is scoped to a single instance of the Provider.
+type ReadCache struct {
+	host string
+
+	lock    sync.RWMutex
+	entries map[string]cachedResponse
+	size    int
+}
+
+// NewReadCache returns an empty ReadCache for requests sent to the specified Resource Manager endpoint
+func NewReadCache(resourceManagerEndpoint string) *ReadCache {
+	host := ""
+	if u, err := url.Parse(resourceManagerEndpoint); err == nil {
+		host = strings.ToLower(u.Host)
+	}
+
+	return &ReadCache{
+		host:    host,
+		entries: map[string]cachedResponse{},
+	}
+}
+
+// Wrap returns a Sender which serves GET requests from the ReadCache where possible
+func (c *ReadCache) Wrap(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if c.host == "" || !strings.EqualFold(r.URL.Host, c.host) {
+			return s.Do(r)
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			if !cachedReadsAllowed(r.Context()) {
+				return s.Do(r)
+			}
+
+		case http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete:
+			// actions (e.g. `.../virtualMachines/vm1/start`) are sent as a POST to a child of the resource, which can
+			// change the resource itself
+			c.invalidate(r)
+			return s.Do(r)
+
+		default:
+			return s.Do(r)
+		}
+
+		key := readCacheKey(r)
+		c.lock.RLock()
+		cached, ok := c.entries[key]
+		c.lock.RUnlock()
+		if ok {
+			log.Printf("[DEBUG] Read Cache: returning the cached response for %s", r.URL.Path)
+			return cached.response(r), nil
+		}
+
+		resp, err := s.Do(r)
+		if err != nil || resp == nil || resp.StatusCode != http.StatusOK || !isJSONResponse(resp) {
+			return resp, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if existing, ok := c.entries[key]; ok {
+			c.size -= len(existing.body)
+		}
+		if c.size+len(body) > maxReadCacheSize {
+			log.Printf("[DEBUG] Read Cache: not caching the response for %s since the cache is full", r.URL.Path)
+			delete(c.entries, key)
+			return resp, nil
+		}
+		c.entries[key] = cachedResponse{
+			statusCode: resp.StatusCode,
+			header:     resp.Header.Clone(),
+			body:       body,
+		}
+		c.size += len(body)
+
+		return resp, nil
+	})
+}
+
+// invalidate removes any cached responses for URLs which are a parent or child of the URL being modified
+func (c *ReadCache) invalidate(r *http.Request) {
+	path := readCachePath(r)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key := range c.entries {
+		cachedPath := strings.SplitN(key, "?", 2)[0]
+		if isPathPrefix(cachedPath, path) || isPathPrefix(path, cachedPath) {
+			log.Printf("[DEBUG] Read Cache: invalidating the cached response for %s due to %s %s", cachedPath, r.Method, r.URL.Path)
+			c.size -= len(c.entries[key].body)
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (r cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(r.statusCode),
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// readCacheKey returns the key for the request, which is the host and (case-insensitive) path - since Resource IDs are
+// case-insensitive - along with the query string, which includes the api-version, and any headers which change the response
+func readCacheKey(r *http.Request) string {
+	key := readCachePath(r) + "?" + r.URL.Query().Encode()
+	for _, header := range readCacheKeyHeaders {
+		if v := r.Header.Values(header); len(v) > 0 {
+			key += "\n" + strings.ToLower(header) + ": " + strings.Join(v, ", ")
+		}
+	}
+	return key
+}
+
+func readCachePath(r *http.Request) string {
+	return strings.ToLower(r.URL.Host + "/" + strings.Trim(r.URL.Path, "/"))
+}
+
+// isJSONResponse returns whether the response is a JSON document, as returned by Resource Manager
+func isJSONResponse(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
+
+// isPathPrefix returns whether the path `prefix` is equal to, or a parent of, the path `input`
+func isPathPrefix(prefix, input string) bool {
+	return input == prefix || strings.HasPrefix(input, prefix+"/")
+}
@@ -0,0 +1,188 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestReadCache(t *testing.T) {
+	calls := map[string]int{}
+	cache := NewReadCache("https://management.azure.com/")
+	s := cache.Wrap(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		calls[r.Method+" "+r.URL.Path]++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader(`{"name":"example"}`)),
+			Request:    r,
+		}, nil
+	}))
+
+	networkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	subnetId := networkId + "/subnets/subnet1"
+	ctx := WithCachedReads(context.TODO())
+
+	send := func(ctx context.Context, method, path, apiVersion string) string {
+		req, _ := http.NewRequestWithContext(ctx, method, "https://management.azure.com"+path+"?api-version="+apiVersion, nil)
+		resp, err := s.Do(req)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	for i := 0; i < 3; i++ {
+		if body := send(ctx, http.MethodGet, networkId, "2021-05-01"); body != `{"name":"example"}` {
+			t.Fatalf("expected the response body to be returned but got %q", body)
+		}
+	}
+	if calls["GET "+networkId] != 1 {
+		t.Fatalf("expected the Virtual Network to be retrieved once but got %d", calls["GET "+networkId])
+	}
+
+	// the key is case-insensitive but includes the api-version
+	send(ctx, http.MethodGet, strings.ToUpper(networkId), "2021-05-01")
+	send(ctx, http.MethodGet, networkId, "2021-08-01")
+	if calls["GET "+networkId] != 2 {
+		t.Fatalf("expected a different api-version to be retrieved separately but got %d", calls["GET "+networkId])
+	}
+
+	// requests using a context without cached reads (e.g. polling during an apply) aren't cached
+	send(context.TODO(), http.MethodGet, networkId, "2021-05-01")
+	if calls["GET "+networkId] != 3 {
+		t.Fatalf("expected the request to be sent but got %d", calls["GET "+networkId])
+	}
+
+	// writing to a child resource invalidates the parent
+	send(ctx, http.MethodGet, subnetId, "2021-05-01")
+	send(ctx, http.MethodPut, subnetId, "2021-05-01")
+	send(ctx, http.MethodGet, networkId, "2021-05-01")
+	send(ctx, http.MethodGet, subnetId, "2021-05-01")
+	if calls["GET "+networkId] != 4 || calls["GET "+subnetId] != 2 {
+		t.Fatalf("expected the cached responses to be invalidated but got %+v", calls)
+	}
+
+	// as does an action against the resource
+	send(ctx, http.MethodPost, networkId+"/checkIPAddressAvailability", "2021-05-01")
+	send(ctx, http.MethodGet, networkId, "2021-05-01")
+	if calls["GET "+networkId] != 5 {
+		t.Fatalf("expected the cached response to be invalidated by the action but got %d", calls["GET "+networkId])
+	}
+
+	// whereas writing to a sibling doesn't
+	send(ctx, http.MethodDelete, networkId+"2", "2021-05-01")
+	send(ctx, http.MethodGet, networkId, "2021-05-01")
+	if calls["GET "+networkId] != 5 {
+		t.Fatalf("expected the cached response to be returned but got %d", calls["GET "+networkId])
+	}
+
+	// the headers which change the response form part of the key
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com"+networkId+"?api-version=2021-05-01", nil)
+	req.Header.Set("Accept", "application/xml")
+	if _, err := s.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if calls["GET "+networkId] != 6 {
+		t.Fatalf("expected a request with a different Accept header to be retrieved separately but got %d", calls["GET "+networkId])
+	}
+}
+
+func TestReadCacheOnlyCachesResourceManagerJSON(t *testing.T) {
+	calls := map[string]int{}
+	cache := NewReadCache("https://management.azure.com/")
+	s := cache.Wrap(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		calls[r.URL.Host+r.URL.Path]++
+		contentType := "application/json"
+		if r.URL.Path == "/providers/Microsoft.Example/text" {
+			contentType = "text/plain"
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{contentType}},
+			Body:       io.NopCloser(strings.NewReader(r.URL.Path)),
+			Request:    r,
+		}, nil
+	}))
+
+	ctx := WithCachedReads(context.TODO())
+	send := func(uri string) string {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+		resp, err := s.Do(req)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	// data plane requests aren't cached, where names are case-sensitive
+	for i := 0; i < 2; i++ {
+		if body := send("https://account1.blob.core.windows.net/container1/Blob1"); body != "/container1/Blob1" {
+			t.Fatalf("expected the response for `Blob1` but got %q", body)
+		}
+		if body := send("https://account1.blob.core.windows.net/container1/blob1"); body != "/container1/blob1" {
+			t.Fatalf("expected the response for `blob1` but got %q", body)
+		}
+	}
+	if calls["account1.blob.core.windows.net/container1/Blob1"] != 2 || calls["account1.blob.core.windows.net/container1/blob1"] != 2 {
+		t.Fatalf("expected the data plane requests to be sent each time but got %+v", calls)
+	}
+
+	// nor are responses from Resource Manager which aren't JSON
+	send("https://management.azure.com/providers/Microsoft.Example/text")
+	send("https://management.azure.com/providers/Microsoft.Example/text")
+	if calls["management.azure.com/providers/Microsoft.Example/text"] != 2 {
+		t.Fatalf("expected the non-JSON response not to be cached but got %+v", calls)
+	}
+}
+
+func TestWithCachedReadsOutsideApply(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":   "example",
+			"name": "example",
+		},
+	}
+
+	// a refresh, where there's no diff
+	if !cachedReadsAllowed(WithCachedReadsOutsideApply(context.TODO(), resource.Data(state))) {
+		t.Fatalf("expected cached reads to be allowed during a refresh")
+	}
+
+	// reading after an update
+	diff, err := resource.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "updated"}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("building ResourceData: %+v", err)
+	}
+	if cachedReadsAllowed(WithCachedReadsOutsideApply(context.TODO(), d)) {
+		t.Fatalf("expected cached reads not to be allowed during an apply")
+	}
+
+	// reading after a create
+	d = resource.Data(nil)
+	d.MarkNewResource()
+	if cachedReadsAllowed(WithCachedReadsOutsideApply(context.TODO(), d)) {
+		t.Fatalf("expected cached reads not to be allowed after creating a resource")
+	}
+}
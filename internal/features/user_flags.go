@@ -1,9 +1,12 @@
 package features
 
+import "time"
+
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
 	ApplicationInsights    ApplicationInsightFeatures
 	CognitiveAccount       CognitiveAccountFeatures
+	DefaultTimeouts        map[string]TimeoutFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
@@ -12,6 +15,15 @@ type UserFeatures struct {
 	ResourceGroup          ResourceGroupFeatures
 }
 
+// TimeoutFeatures are the default timeouts for a resource type, where a zero value means that the
+// default timeout for the resource is used
+type TimeoutFeatures struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
@@ -0,0 +1,77 @@
+package locks
+
+import (
+	"sync"
+)
+
+const (
+	// BackendTypeMemory serializes operations within the current process only
+	BackendTypeMemory = "memory"
+
+	// BackendTypeFile serializes operations across processes on the same machine using lock files
+	BackendTypeFile = "file"
+)
+
+// BackendTypes returns the types of Lock Backend which can be configured in the Provider block
+func BackendTypes() []string {
+	return []string{
+		BackendTypeMemory,
+		BackendTypeFile,
+	}
+}
+
+// Backend is a store of locks which can be acquired and released by key, the caller is responsible
+// for calling Unlock for each key it has called Lock for
+type Backend interface {
+	// Lock acquires the lock for the given key, blocking until it's available
+	Lock(key string)
+
+	// Unlock releases the lock for the given key
+	Unlock(key string)
+}
+
+var (
+	backendLock sync.Mutex
+	backend     Backend = armMutexKV
+
+	// heldBy tracks the Backend used to acquire each lock, so that a lock is always released using the
+	// same Backend - even if the Backend has been changed (e.g. by another Provider alias) in the interim
+	heldBy = map[string]Backend{}
+)
+
+// SetBackend configures the Backend used for locks acquired from this point onwards, this is shared by all
+// instances of the Provider within the current process
+func SetBackend(input Backend) {
+	backendLock.Lock()
+	defer backendLock.Unlock()
+
+	if input == nil {
+		input = armMutexKV
+	}
+	backend = input
+}
+
+func lock(key string) {
+	backendLock.Lock()
+	b := backend
+	backendLock.Unlock()
+
+	b.Lock(key)
+
+	backendLock.Lock()
+	heldBy[key] = b
+	backendLock.Unlock()
+}
+
+func unlock(key string) {
+	backendLock.Lock()
+	b, ok := heldBy[key]
+	if ok {
+		delete(heldBy, key)
+	} else {
+		b = backend
+	}
+	backendLock.Unlock()
+
+	b.Unlock(key)
+}
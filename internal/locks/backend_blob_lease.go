@@ -0,0 +1,133 @@
+package locks
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// blobLeaseDuration is the duration of each lease, which is renewed whilst the lock is held - Azure Storage
+	// supports leases of between 15 and 60 seconds
+	blobLeaseDuration = 60 * time.Second
+
+	blobLeasePollInterval = 2 * time.Second
+)
+
+// BlobLeaseClient is the subset of the Blob Storage Data Plane API used to acquire, renew and release
+// leases on a Blob - allowing operations to be serialized across machines
+type BlobLeaseClient interface {
+	// AcquireLease acquires a lease on the specified Blob (creating it if required) returning the Lease ID,
+	// or an error if the Blob is already leased
+	AcquireLease(ctx context.Context, blobName string, duration time.Duration) (string, error)
+
+	// RenewLease renews the specified lease on the Blob
+	RenewLease(ctx context.Context, blobName string, leaseId string) error
+
+	// ReleaseLease releases the specified lease on the Blob
+	ReleaseLease(ctx context.Context, blobName string, leaseId string) error
+}
+
+var _ Backend = &blobLeaseBackend{}
+
+type blobLease struct {
+	id   string
+	stop chan struct{}
+}
+
+// blobLeaseBackend serializes operations across machines by acquiring a lease on a Blob for each key
+type blobLeaseBackend struct {
+	client       BlobLeaseClient
+	pollInterval time.Duration
+
+	// local serializes operations within the current process, prior to acquiring the lease
+	local *mutexKV
+
+	lock   sync.Mutex
+	leases map[string]blobLease
+}
+
+// NewBlobLeaseBackend returns a Backend which acquires a lease on a Blob for each key using the specified client
+func NewBlobLeaseBackend(client BlobLeaseClient) Backend {
+	return &blobLeaseBackend{
+		client:       client,
+		pollInterval: blobLeasePollInterval,
+		local:        armMutexKV,
+		leases:       map[string]blobLease{},
+	}
+}
+
+func (b *blobLeaseBackend) Lock(key string) {
+	b.local.Lock(key)
+
+	blobName := b.blobName(key)
+	log.Printf("[DEBUG] Acquiring a lease on the Blob %q for %q", blobName, key)
+	var leaseId string
+	for {
+		id, err := b.client.AcquireLease(context.Background(), blobName, blobLeaseDuration)
+		if err == nil {
+			leaseId = id
+			break
+		}
+
+		log.Printf("[DEBUG] Unable to acquire a lease on the Blob %q for %q, retrying: %+v", blobName, key, err)
+		time.Sleep(b.pollInterval)
+	}
+	log.Printf("[DEBUG] Acquired the lease %q on the Blob %q for %q", leaseId, blobName, key)
+
+	lease := blobLease{
+		id:   leaseId,
+		stop: make(chan struct{}),
+	}
+	b.lock.Lock()
+	b.leases[key] = lease
+	b.lock.Unlock()
+
+	go b.renew(blobName, lease)
+}
+
+func (b *blobLeaseBackend) Unlock(key string) {
+	b.lock.Lock()
+	lease, ok := b.leases[key]
+	delete(b.leases, key)
+	b.lock.Unlock()
+
+	if ok {
+		close(lease.stop)
+
+		blobName := b.blobName(key)
+		log.Printf("[DEBUG] Releasing the lease %q on the Blob %q for %q", lease.id, blobName, key)
+		if err := b.client.ReleaseLease(context.Background(), blobName, lease.id); err != nil {
+			// the lease will expire by itself, so this isn't fatal
+			log.Printf("[ERROR] releasing the lease %q on the Blob %q for %q: %+v", lease.id, blobName, key, err)
+		}
+	}
+
+	b.local.Unlock(key)
+}
+
+// renew periodically renews the lease until the lock is released
+func (b *blobLeaseBackend) renew(blobName string, lease blobLease) {
+	ticker := time.NewTicker(blobLeaseDuration / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-lease.stop:
+			return
+		case <-ticker.C:
+			if err := b.client.RenewLease(context.Background(), blobName, lease.id); err != nil {
+				log.Printf("[ERROR] renewing the lease %q on the Blob %q: %+v", lease.id, blobName, err)
+			}
+		}
+	}
+}
+
+// blobName returns the name of the Blob used for the specified key, since keys can contain characters
+// which aren't valid within a Blob name (such as Resource IDs) a hash of the key is used
+func (b *blobLeaseBackend) blobName(key string) string {
+	return fmt.Sprintf("%x.lock", sha256.Sum256([]byte(key)))
+}
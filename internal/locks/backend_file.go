@@ -0,0 +1,260 @@
+package locks
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// fileLockStaleAfter is the duration after which a lock file which hasn't been refreshed is assumed to
+	// belong to a process which has exited without releasing it
+	fileLockStaleAfter = 2 * time.Minute
+
+	fileLockPollInterval = 500 * time.Millisecond
+)
+
+// DefaultFileBackendDirectory returns the directory used for lock files when one isn't specified
+func DefaultFileBackendDirectory() string {
+	return filepath.Join(os.TempDir(), "terraform-provider-azurerm-locks")
+}
+
+var _ Backend = &fileBackend{}
+
+// fileBackend serializes operations across processes on the same machine (or sharing the same directory)
+// by exclusively creating a lock file for each key. Whilst a lock is held the lock file is refreshed
+// periodically, so that a lock file left behind by a process which has exited can be detected as stale.
+//
+// Each lock file contains a token unique to the holder, which is checked before the lock file is removed
+// (either when the lock is released or taken over once stale) - to avoid removing a lock held by another process.
+type fileBackend struct {
+	directory    string
+	staleAfter   time.Duration
+	pollInterval time.Duration
+
+	// local serializes operations within the current process, prior to acquiring the lock file
+	local *mutexKV
+
+	lock sync.Mutex
+	held map[string]*heldFileLock
+}
+
+// heldFileLock is a lock file held by this process
+type heldFileLock struct {
+	contents string
+	stop     chan struct{}
+}
+
+// NewFileBackend returns a Backend which uses lock files within the specified directory, which is created if it doesn't exist
+func NewFileBackend(directory string) (Backend, error) {
+	if directory == "" {
+		directory = DefaultFileBackendDirectory()
+	}
+
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("creating the lock directory %q: %+v", directory, err)
+	}
+
+	// validate that we're able to create lock files, rather than waiting forever during the first Lock
+	f, err := os.CreateTemp(directory, "check-*")
+	if err != nil {
+		return nil, fmt.Errorf("validating the lock directory %q is writable: %+v", directory, err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	return &fileBackend{
+		directory:    directory,
+		staleAfter:   fileLockStaleAfter,
+		pollInterval: fileLockPollInterval,
+		local:        armMutexKV,
+		held:         map[string]*heldFileLock{},
+	}, nil
+}
+
+func (b *fileBackend) Lock(key string) {
+	b.local.Lock(key)
+
+	path := b.path(key)
+	hostname, _ := os.Hostname()
+	contents := fmt.Sprintf("%s\nhost=%s pid=%d nonce=%s\n", key, hostname, os.Getpid(), newNonce())
+
+	log.Printf("[DEBUG] Acquiring the lock file %q for %q", path, key)
+	for {
+		acquired, err := b.tryLock(key, path, contents)
+		if err != nil {
+			log.Printf("[ERROR] acquiring the lock file %q for %q: %+v", path, key, err)
+		}
+		if acquired {
+			break
+		}
+
+		time.Sleep(b.pollInterval)
+	}
+	log.Printf("[DEBUG] Acquired the lock file %q for %q", path, key)
+
+	held := &heldFileLock{
+		contents: contents,
+		stop:     make(chan struct{}),
+	}
+	b.lock.Lock()
+	b.held[key] = held
+	b.lock.Unlock()
+
+	go b.refresh(path, held.stop)
+}
+
+func (b *fileBackend) Unlock(key string) {
+	b.lock.Lock()
+	held, ok := b.held[key]
+	if ok {
+		close(held.stop)
+		delete(b.held, key)
+	}
+	b.lock.Unlock()
+
+	path := b.path(key)
+	if ok {
+		log.Printf("[DEBUG] Releasing the lock file %q for %q", path, key)
+		removed, err := removeLockFile(path, held.contents)
+		if err != nil {
+			log.Printf("[ERROR] removing the lock file %q for %q: %+v", path, key, err)
+		} else if !removed {
+			log.Printf("[WARN] The lock file %q for %q is no longer held by this process - leaving it in place", path, key)
+		}
+	}
+
+	b.local.Unlock(key)
+}
+
+func (b *fileBackend) tryLock(key, path, contents string) (bool, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err == nil {
+		defer f.Close()
+		if _, err := f.WriteString(contents); err != nil {
+			return true, fmt.Errorf("writing lock file: %+v", err)
+		}
+		return true, nil
+	}
+	if !os.IsExist(err) {
+		return false, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// released in the interim
+			return false, nil
+		}
+		return false, err
+	}
+
+	if age := time.Since(info.ModTime()); age > b.staleAfter {
+		existing, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("reading stale lock file: %+v", err)
+		}
+
+		log.Printf("[WARN] The lock file %q for %q hasn't been refreshed for %s - assuming the process holding it has exited and removing it", path, key, age)
+		if _, err := removeLockFile(path, string(existing)); err != nil {
+			return false, fmt.Errorf("removing stale lock file: %+v", err)
+		}
+	}
+
+	return false, nil
+}
+
+// removeLockFile removes the lock file at the specified path, providing it contains the expected contents. The contents
+// are checked prior to the lock file being touched, so that a lock file belonging to another holder is left in place - the
+// lock file is then atomically renamed out of the way, so that only a single process can remove a given lock file.
+func removeLockFile(path, expected string) (bool, error) {
+	actual, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// released or taken over by another process in the interim
+			return false, nil
+		}
+		return false, fmt.Errorf("reading lock file: %+v", err)
+	}
+	if string(actual) != expected {
+		return false, nil
+	}
+
+	removed := fmt.Sprintf("%s.%s.removed", path, newNonce())
+	if err := os.Rename(path, removed); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer os.Remove(removed)
+
+	actual, err = os.ReadFile(removed)
+	if err != nil {
+		return false, fmt.Errorf("reading lock file: %+v", err)
+	}
+	if string(actual) == expected {
+		return true, nil
+	}
+
+	// the lock file was taken over by another process between being checked and renamed
+	if err := restoreLockFile(removed, path); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// restoreLockFile moves a lock file belonging to another holder back into place, without replacing any lock file
+// created in the interim - should one have been, both holders now believe they hold the lock, which is surfaced as an error
+func restoreLockFile(removed, path string) error {
+	if err := os.Link(removed, path); err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("restoring the lock file held by another process: the lock has since been acquired by a third process, as such the lock may be held by two processes")
+		}
+		return fmt.Errorf("restoring the lock file held by another process: %+v", err)
+	}
+
+	return nil
+}
+
+// newNonce returns a random value used to make lock files (and their removal) unique to the caller
+func newNonce() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// refresh periodically updates the modification time of the lock file until the lock is released
+func (b *fileBackend) refresh(path string, stop chan struct{}) {
+	ticker := time.NewTicker(b.staleAfter / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			now := time.Now()
+			if err := os.Chtimes(path, now, now); err != nil {
+				log.Printf("[ERROR] refreshing the lock file %q: %+v", path, err)
+			}
+		}
+	}
+}
+
+// path returns the path to the lock file for the specified key, since keys can contain characters which aren't
+// valid within a filename (such as Resource IDs) a hash of the key is used
+func (b *fileBackend) path(key string) string {
+	return filepath.Join(b.directory, fmt.Sprintf("%x.lock", sha256.Sum256([]byte(key))))
+}
@@ -0,0 +1,219 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFileBackendSerializesAcrossBackends(t *testing.T) {
+	directory := t.TempDir()
+
+	// each backend is given its own in-process mutex to simulate separate processes
+	first := testFileBackend(t, directory)
+	second := testFileBackend(t, directory)
+
+	key := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1"
+	first.Lock(key)
+
+	acquired := make(chan struct{})
+	go func() {
+		second.Lock(key)
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected the second backend to wait for the lock to be released")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	first.Unlock(key)
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the second backend to acquire the lock once released")
+	}
+	second.Unlock(key)
+
+	if _, err := os.Stat(first.path(key)); !os.IsNotExist(err) {
+		t.Fatalf("expected the lock file to be removed once released but got %+v", err)
+	}
+}
+
+func TestFileBackendRemovesStaleLocks(t *testing.T) {
+	backend := testFileBackend(t, t.TempDir())
+	key := "example"
+
+	path := backend.path(key)
+	if err := os.WriteFile(path, []byte("abandoned"), 0600); err != nil {
+		t.Fatalf("writing lock file: %+v", err)
+	}
+	old := time.Now().Add(-2 * backend.staleAfter)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatalf("updating lock file: %+v", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		backend.Lock(key)
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the stale lock file to be removed")
+	}
+	backend.Unlock(key)
+}
+
+func TestFileBackendUnlockChecksOwnership(t *testing.T) {
+	backend := testFileBackend(t, t.TempDir())
+	key := "example"
+
+	backend.Lock(key)
+
+	// simulate the lock being taken over by another process
+	path := backend.path(key)
+	if err := os.WriteFile(path, []byte("another process"), 0600); err != nil {
+		t.Fatalf("writing lock file: %+v", err)
+	}
+
+	backend.Unlock(key)
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected the lock file held by another process to be retained but got %+v", err)
+	}
+	if string(contents) != "another process" {
+		t.Fatalf("expected the lock file held by another process to be unchanged but got %q", string(contents))
+	}
+}
+
+func TestRemoveLockFileLeavesLocksHeldByOthers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "example.lock")
+	if err := os.WriteFile(path, []byte("fresh"), 0600); err != nil {
+		t.Fatalf("writing lock file: %+v", err)
+	}
+
+	removed, err := removeLockFile(path, "stale")
+	if err != nil {
+		t.Fatalf("removing lock file: %+v", err)
+	}
+	if removed {
+		t.Fatalf("expected a lock file with different contents not to be removed")
+	}
+	if contents, err := os.ReadFile(path); err != nil || string(contents) != "fresh" {
+		t.Fatalf("expected the lock file to be left in place but got %q / %+v", string(contents), err)
+	}
+
+	removed, err = removeLockFile(path, "fresh")
+	if err != nil {
+		t.Fatalf("removing lock file: %+v", err)
+	}
+	if !removed {
+		t.Fatalf("expected the lock file to be removed")
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("listing directory: %+v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected no files to remain but got %d", len(entries))
+	}
+}
+
+func TestRestoreLockFile(t *testing.T) {
+	directory := t.TempDir()
+	path := filepath.Join(directory, "example.lock")
+	removed := path + ".removed"
+	if err := os.WriteFile(removed, []byte("another process"), 0600); err != nil {
+		t.Fatalf("writing lock file: %+v", err)
+	}
+
+	if err := restoreLockFile(removed, path); err != nil {
+		t.Fatalf("restoring lock file: %+v", err)
+	}
+	if contents, err := os.ReadFile(path); err != nil || string(contents) != "another process" {
+		t.Fatalf("expected the lock file to be restored but got %q / %+v", string(contents), err)
+	}
+
+	// a lock file created by a third process in the interim is retained, but reported
+	if err := os.WriteFile(path, []byte("third process"), 0600); err != nil {
+		t.Fatalf("writing lock file: %+v", err)
+	}
+	if err := restoreLockFile(removed, path); err == nil {
+		t.Fatalf("expected an error when the lock file has since been acquired by another process")
+	}
+	if contents, err := os.ReadFile(path); err != nil || string(contents) != "third process" {
+		t.Fatalf("expected the lock file created in the interim to be retained but got %q / %+v", string(contents), err)
+	}
+}
+
+type fakeBlobLeaseClient struct {
+	lock   sync.Mutex
+	leases map[string]string
+	count  int
+}
+
+func (c *fakeBlobLeaseClient) AcquireLease(_ context.Context, blobName string, _ time.Duration) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.leases[blobName]; ok {
+		return "", fmt.Errorf("LeaseAlreadyPresent")
+	}
+	c.count++
+	c.leases[blobName] = fmt.Sprintf("lease-%d", c.count)
+	return c.leases[blobName], nil
+}
+
+func (c *fakeBlobLeaseClient) RenewLease(_ context.Context, _ string, _ string) error {
+	return nil
+}
+
+func (c *fakeBlobLeaseClient) ReleaseLease(_ context.Context, blobName string, leaseId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.leases[blobName] != leaseId {
+		return fmt.Errorf("LeaseIdMismatchWithLeaseOperation")
+	}
+	delete(c.leases, blobName)
+	return nil
+}
+
+func TestBlobLeaseBackend(t *testing.T) {
+	client := &fakeBlobLeaseClient{
+		leases: map[string]string{},
+	}
+	backend := NewBlobLeaseBackend(client).(*blobLeaseBackend)
+	backend.local = NewMutexKV()
+
+	backend.Lock("example")
+	if len(client.leases) != 1 {
+		t.Fatalf("expected 1 lease but got %d", len(client.leases))
+	}
+	backend.Unlock("example")
+	if len(client.leases) != 0 {
+		t.Fatalf("expected the lease to be released but got %d", len(client.leases))
+	}
+}
+
+func testFileBackend(t *testing.T, directory string) *fileBackend {
+	b, err := NewFileBackend(directory)
+	if err != nil {
+		t.Fatalf("building file backend: %+v", err)
+	}
+	backend := b.(*fileBackend)
+	backend.local = NewMutexKV()
+	backend.pollInterval = 10 * time.Millisecond
+	return backend
+}
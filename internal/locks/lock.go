@@ -1,33 +1,46 @@
 package locks
 
+import (
+	"context"
+	"log"
+	"sort"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
 func ByID(id string) {
-	armMutexKV.Lock(id)
+	lock(id)
 }
 
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
+	armLockOrder.acquiring(goroutineID(), resourceType)
+
 	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	lock(updatedName)
 }
 
 func MultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
 
+	// the names are locked in a consistent order, so that two callers locking an overlapping set of names can't deadlock
+	sort.Strings(newSlice)
+
 	for _, name := range newSlice {
 		ByName(name, resourceType)
 	}
 }
 
 func UnlockByID(id string) {
-	armMutexKV.Unlock(id)
+	unlock(id)
 }
 
 func UnlockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	unlock(updatedName)
+
+	armLockOrder.released(goroutineID(), resourceType)
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
@@ -37,3 +50,26 @@ func UnlockMultipleByName(names *[]string, resourceType string) {
 		UnlockByName(name, resourceType)
 	}
 }
+
+// Holders returns the current holders of each lock
+func Holders() []Holder {
+	return armMutexKV.Holders()
+}
+
+// LogHoldersOnCancel logs the current holders of each lock when the specified context is cancelled, to allow
+// diagnosing which lock an operation was waiting on when Terraform was interrupted
+func LogHoldersOnCancel(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+
+		holders := Holders()
+		if len(holders) == 0 {
+			return
+		}
+
+		log.Printf("[WARN] The context was cancelled whilst %d lock(s) were held:", len(holders))
+		for _, holder := range holders {
+			log.Printf("[WARN]   %s", holder)
+		}
+	}()
+}
@@ -0,0 +1,75 @@
+package locks
+
+import (
+	"log"
+	"sync"
+)
+
+// lockOrder tracks the order in which locks for each resource type are acquired by each goroutine, so that
+// callers acquiring locks in an inconsistent order (which can deadlock) can be detected - for example one
+// caller locking a Virtual Network then a Subnet, whilst another locks a Subnet then a Virtual Network
+type lockOrder struct {
+	lock sync.Mutex
+
+	// held is the resource types currently locked by each goroutine, in the order they were acquired
+	held map[uint64][]string
+
+	// observed is a map of resource type to the resource types which have been locked whilst holding it
+	observed map[string]map[string]struct{}
+}
+
+var armLockOrder = newLockOrder()
+
+func newLockOrder() *lockOrder {
+	return &lockOrder{
+		held:     map[uint64][]string{},
+		observed: map[string]map[string]struct{}{},
+	}
+}
+
+// acquiring records that the current goroutine is acquiring a lock for the specified resource type, returning
+// false (and logging a warning) if this is inconsistent with the order in which locks have previously been acquired
+func (o *lockOrder) acquiring(goroutine uint64, resourceType string) bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	consistent := true
+	for _, existing := range o.held[goroutine] {
+		if existing == resourceType {
+			continue
+		}
+
+		if _, ok := o.observed[resourceType][existing]; ok {
+			log.Printf("[WARN] Potential deadlock: acquiring a lock for %q whilst holding a lock for %q, however a lock for %q has previously been acquired whilst holding a lock for %q - locks should be acquired in a consistent order (%s)", resourceType, existing, existing, resourceType, callerOutsidePackage())
+			consistent = false
+		}
+
+		if _, ok := o.observed[existing]; !ok {
+			o.observed[existing] = map[string]struct{}{}
+		}
+		o.observed[existing][resourceType] = struct{}{}
+	}
+
+	o.held[goroutine] = append(o.held[goroutine], resourceType)
+	return consistent
+}
+
+// released records that the current goroutine has released a lock for the specified resource type
+func (o *lockOrder) released(goroutine uint64, resourceType string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	held := o.held[goroutine]
+	for i := len(held) - 1; i >= 0; i-- {
+		if held[i] == resourceType {
+			held = append(held[:i], held[i+1:]...)
+			break
+		}
+	}
+
+	if len(held) == 0 {
+		delete(o.held, goroutine)
+		return
+	}
+	o.held[goroutine] = held
+}
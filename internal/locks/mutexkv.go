@@ -1,35 +1,132 @@
 package locks
 
 import (
+	"bytes"
+	"fmt"
 	"log"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
+// defaultLongHoldThreshold is the duration after which a warning is logged when a lock is held, or waited on
+const defaultLongHoldThreshold = 5 * time.Minute
+
+// Holder describes the current holder of a lock
+type Holder struct {
+	// Key is the key of the lock being held
+	Key string
+
+	// Goroutine is the ID of the goroutine which acquired the lock
+	Goroutine uint64
+
+	// Caller is the function (and location) outside of this package which acquired the lock
+	Caller string
+
+	// AcquiredAt is the time at which the lock was acquired
+	AcquiredAt time.Time
+}
+
+func (h Holder) String() string {
+	return fmt.Sprintf("%q held by goroutine %d (%s) for %s", h.Key, h.Goroutine, h.Caller, time.Since(h.AcquiredAt).Round(time.Second))
+}
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// The holder of each lock is tracked so that contention can be diagnosed, with a
+// warning logged when a lock is held (or waited on) for longer than warnAfter.
 type mutexKV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	lock      sync.Mutex
+	store     map[string]*sync.Mutex
+	holders   map[string]Holder
+	timers    map[string]*time.Timer
+	warnAfter time.Duration
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
+	caller := callerOutsidePackage()
+	log.Printf("[DEBUG] Locking %q (%s)", key, caller)
+
+	mutex, holder, held := m.get(key)
+	if held {
+		log.Printf("[DEBUG] Waiting for %s", holder)
+	}
+
+	waiting := time.AfterFunc(m.warnAfter, func() {
+		if holder, ok := m.holder(key); ok {
+			log.Printf("[WARN] %s has been waiting for %s to lock %s", caller, m.warnAfter, holder)
+		}
+	})
+	mutex.Lock()
+	waiting.Stop()
+
+	m.lock.Lock()
+	m.holders[key] = Holder{
+		Key:        key,
+		Goroutine:  goroutineID(),
+		Caller:     caller,
+		AcquiredAt: time.Now(),
+	}
+	m.timers[key] = time.AfterFunc(m.warnAfter, func() {
+		if holder, ok := m.holder(key); ok {
+			log.Printf("[WARN] Long-held lock: %s", holder)
+		}
+	})
+	m.lock.Unlock()
+
 	log.Printf("[DEBUG] Locked %q", key)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	m.lock.Lock()
+	if timer, ok := m.timers[key]; ok {
+		timer.Stop()
+		delete(m.timers, key)
+	}
+	delete(m.holders, key)
+	m.lock.Unlock()
+
+	mutex, _, _ := m.get(key)
+	mutex.Unlock()
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// Holders returns the current holders of each lock, ordered by the time they were acquired
+func (m *mutexKV) Holders() []Holder {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	holders := make([]Holder, 0, len(m.holders))
+	for _, v := range m.holders {
+		holders = append(holders, v)
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].AcquiredAt.Before(holders[j].AcquiredAt)
+	})
+	return holders
+}
+
+func (m *mutexKV) holder(key string) (Holder, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	holder, ok := m.holders[key]
+	return holder, ok
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status - alongside
+// the current holder of the mutex, if any
+func (m *mutexKV) get(key string) (*sync.Mutex, Holder, bool) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
@@ -37,12 +134,45 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 		mutex = &sync.Mutex{}
 		m.store[key] = mutex
 	}
-	return mutex
+	holder, held := m.holders[key]
+	return mutex, holder, held
 }
 
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store:     make(map[string]*sync.Mutex),
+		holders:   make(map[string]Holder),
+		timers:    make(map[string]*time.Timer),
+		warnAfter: defaultLongHoldThreshold,
+	}
+}
+
+// goroutineID returns the ID of the current goroutine, which is only intended for diagnostic purposes
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	// the stack trace is prefixed with `goroutine 123 [running]:`
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i > 0 {
+		buf = buf[:i]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
+
+// callerOutsidePackage returns the first function in the call stack outside of this package, in the format
+// `function (file:line)` - which identifies the operation acquiring a lock
+func callerOutsidePackage() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/hashicorp/terraform-provider-azurerm/internal/locks.") {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
 	}
 }
@@ -0,0 +1,98 @@
+package locks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMutexKVTracksHolders(t *testing.T) {
+	m := NewMutexKV()
+
+	m.Lock("first")
+	m.Lock("second")
+
+	holders := m.Holders()
+	if len(holders) != 2 {
+		t.Fatalf("expected 2 holders but got %d", len(holders))
+	}
+	if holders[0].Key != "first" || holders[1].Key != "second" {
+		t.Fatalf("expected the holders to be ordered by acquisition time but got %+v", holders)
+	}
+	if holders[0].Goroutine != goroutineID() {
+		t.Fatalf("expected the holder to be goroutine %d but got %d", goroutineID(), holders[0].Goroutine)
+	}
+	if holders[0].Caller == "" {
+		t.Fatalf("expected the caller to be set")
+	}
+
+	m.Unlock("first")
+	m.Unlock("second")
+
+	if holders := m.Holders(); len(holders) != 0 {
+		t.Fatalf("expected no holders once unlocked but got %+v", holders)
+	}
+}
+
+func TestMutexKVWaitsForHolder(t *testing.T) {
+	m := NewMutexKV()
+	m.warnAfter = 10 * time.Millisecond
+
+	m.Lock("example")
+
+	acquired := make(chan uint64)
+	go func() {
+		m.Lock("example")
+		acquired <- m.Holders()[0].Goroutine
+		m.Unlock("example")
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected the lock to be held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock("example")
+
+	select {
+	case goroutine := <-acquired:
+		if goroutine == goroutineID() {
+			t.Fatalf("expected the holder to be updated to the waiting goroutine")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the lock to be acquired once released")
+	}
+}
+
+func TestLockOrder(t *testing.T) {
+	o := newLockOrder()
+
+	// lock a Virtual Network then a Subnet
+	if !o.acquiring(1, "azurerm_virtual_network") || !o.acquiring(1, "azurerm_subnet") {
+		t.Fatalf("expected the first acquisition to be consistent")
+	}
+	o.released(1, "azurerm_subnet")
+	o.released(1, "azurerm_virtual_network")
+
+	// locking in the same order is fine, as is locking multiple of the same type
+	if !o.acquiring(2, "azurerm_virtual_network") || !o.acquiring(2, "azurerm_virtual_network") || !o.acquiring(2, "azurerm_subnet") {
+		t.Fatalf("expected locking in the same order to be consistent")
+	}
+	o.released(2, "azurerm_subnet")
+	o.released(2, "azurerm_virtual_network")
+	o.released(2, "azurerm_virtual_network")
+
+	// whereas locking a Subnet then a Virtual Network could deadlock
+	if !o.acquiring(3, "azurerm_subnet") {
+		t.Fatalf("expected the Subnet to be consistent")
+	}
+	if o.acquiring(3, "azurerm_virtual_network") {
+		t.Fatalf("expected locking in the opposite order to be detected")
+	}
+	o.released(3, "azurerm_virtual_network")
+	o.released(3, "azurerm_subnet")
+
+	if len(o.held) != 0 {
+		t.Fatalf("expected no locks to be held but got %+v", o.held)
+	}
+}
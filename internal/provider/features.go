@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -49,6 +52,44 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"default_timeouts": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"create": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTimeoutDuration,
+					},
+
+					"read": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTimeoutDuration,
+					},
+
+					"update": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTimeoutDuration,
+					},
+
+					"delete": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateTimeoutDuration,
+					},
+				},
+			},
+		},
+
 		"cognitive_account": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -407,5 +448,55 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["default_timeouts"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			featuresMap.DefaultTimeouts = make(map[string]features.TimeoutFeatures)
+		}
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			timeoutsRaw := item.(map[string]interface{})
+			// the durations have been validated at this point, so any errors can be ignored
+			createTimeout, _ := parseTimeoutDuration(timeoutsRaw["create"])
+			readTimeout, _ := parseTimeoutDuration(timeoutsRaw["read"])
+			updateTimeout, _ := parseTimeoutDuration(timeoutsRaw["update"])
+			deleteTimeout, _ := parseTimeoutDuration(timeoutsRaw["delete"])
+			featuresMap.DefaultTimeouts[timeoutsRaw["resource_type"].(string)] = features.TimeoutFeatures{
+				Create: createTimeout,
+				Read:   readTimeout,
+				Update: updateTimeout,
+				Delete: deleteTimeout,
+			}
+		}
+	}
+
 	return featuresMap
 }
+
+func parseTimeoutDuration(input interface{}) (time.Duration, error) {
+	v, ok := input.(string)
+	if !ok || v == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(v)
+}
+
+func validateTimeoutDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := parseTimeoutDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a duration (for example `30m` or `2h`): %+v", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be a positive duration but got %q", k, v))
+	}
+	return
+}
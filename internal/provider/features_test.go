@@ -3,6 +3,7 @@ package provider
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -1025,3 +1026,84 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesDefaultTimeouts(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]features.TimeoutFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"default_timeouts": []interface{}{},
+				},
+			},
+			Expected: nil,
+		},
+		{
+			Name: "Multiple Resource Types",
+			Input: []interface{}{
+				map[string]interface{}{
+					"default_timeouts": []interface{}{
+						map[string]interface{}{
+							"resource_type": "azurerm_kubernetes_cluster",
+							"create":        "2h",
+							"read":          "",
+							"update":        "",
+							"delete":        "1h30m",
+						},
+						map[string]interface{}{
+							"resource_type": "azurerm_resource_group",
+							"create":        "",
+							"read":          "10m",
+							"update":        "",
+							"delete":        "",
+						},
+					},
+				},
+			},
+			Expected: map[string]features.TimeoutFeatures{
+				"azurerm_kubernetes_cluster": {
+					Create: 2 * time.Hour,
+					Delete: 90 * time.Minute,
+				},
+				"azurerm_resource_group": {
+					Read: 10 * time.Minute,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DefaultTimeouts, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result.DefaultTimeouts)
+		}
+	}
+}
+
+func TestValidateTimeoutDuration(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{Input: "", Valid: true},
+		{Input: "30m", Valid: true},
+		{Input: "1h30m", Valid: true},
+		{Input: "-5m", Valid: false},
+		{Input: "30", Valid: false},
+		{Input: "thirty minutes", Valid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := validateTimeoutDuration(v.Input, "create")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("Expected %t but got %t", v.Valid, valid)
+		}
+	}
+}
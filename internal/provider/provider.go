@@ -11,9 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -97,6 +102,28 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// expose `tags_all` on every taggable resource, which is only populated when `default_tags` or `ignore_tags`
+	// are configured in the provider block
+	for name, resource := range resources {
+		tags.ConfigureResource(name, resource)
+	}
+	for _, dataSource := range dataSources {
+		tags.ConfigureDataSource(dataSource)
+	}
+
+	// validate the `location` is available for the Resource Type, when using Enhanced Validation
+	for _, resource := range resources {
+		resourceproviders.ConfigureLocationValidation(resource)
+	}
+
+	// decode the errors returned from Azure into Diagnostics, for the (non-context aware) untyped resources
+	for _, dataSource := range dataSources {
+		pluginsdk.DiagnosticsShim(dataSource, errorDiagnostics)
+	}
+	for _, resource := range resources {
+		pluginsdk.DiagnosticsShim(resource, errorDiagnostics)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -180,6 +207,19 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The URL for the OIDC provider from which to request an ID token. For use When authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_TOKEN_FILE_PATH", "AZURE_FEDERATED_TOKEN_FILE"}, ""),
+				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
 			"use_oidc": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -187,6 +227,14 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Allow OpenID Connect to be used for authentication",
 			},
 
+			// Azure CLI specific fields
+			"use_cli_tenant": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_CLI_TENANT", false),
+				Description: "Should access tokens be obtained from the Azure CLI for the specified `tenant_id`, rather than the Tenant associated with the Subscription?",
+			},
+
 			// Managed Service Identity specific fields
 			"use_msi": {
 				Type:        schema.TypeBool,
@@ -224,6 +272,102 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"log_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_LOG_FORMAT", sdk.LogFormatText),
+				ValidateFunc: validation.StringInSlice(sdk.LogFormats(), false),
+				Description:  "The format used for log messages output by the AzureRM Provider, either `text` or `json`.",
+			},
+
+			"lock_backend": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_LOCK_BACKEND", locks.BackendTypeMemory),
+				ValidateFunc: validation.StringInSlice(locks.BackendTypes(), false),
+				Description:  "The backend used to serialize operations on shared resources (such as Virtual Networks and Subnets), either `memory` to serialize operations within this process or `file` to serialize operations across processes using lock files.",
+			},
+
+			"lock_directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_LOCK_DIRECTORY", ""),
+				Description: "The directory used for lock files when `lock_backend` is set to `file`. Defaults to a directory within the system's temporary directory.",
+			},
+
+			"rate_limit_reads_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RATE_LIMIT_READS_PER_SECOND", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of read requests sent to Azure per second, shared across all resources. Defaults to `0`, meaning read requests are only limited when throttled by Azure.",
+			},
+
+			"rate_limit_writes_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RATE_LIMIT_WRITES_PER_SECOND", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of write requests sent to Azure per second, shared across all resources. Defaults to `0`, meaning write requests are only limited when throttled by Azure.",
+			},
+
+			"cache_read_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CACHE_READ_REQUESTS", false),
+				Description: "Should the AzureRM Provider cache the responses to read requests made when refreshing resources, so that resources which are retrieved repeatedly (such as a Virtual Network containing many Subnets) are only retrieved once?",
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: tags.Validate,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "The tags which should be assigned to every resource which supports tags.",
+						},
+					},
+				},
+				Description: "The tags which should be assigned to every resource which supports tags, tags specified on a resource take precedence over these.",
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Description: "The keys of tags which should be ignored on every resource.",
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Description: "The prefixes of the keys of tags which should be ignored on every resource.",
+						},
+					},
+				},
+				Description: "The tags which should be ignored on every resource, such as those assigned by Azure Policy.",
+			},
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
 			// Advanced feature flags
@@ -295,11 +439,17 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			UseMicrosoftGraph: true,
 		}
 
-		config, err := builder.Build()
+		config, authorizer, err := buildAuthConfig(builder, d.Get("oidc_token").(string), d.Get("oidc_token_file_path").(string), d.Get("use_cli_tenant").(bool))
 		if err != nil {
 			return nil, diag.Errorf("building AzureRM Client: %s", err)
 		}
 
+		lockBackend, err := expandLockBackend(d.Get("lock_backend").(string), d.Get("lock_directory").(string))
+		if err != nil {
+			return nil, diag.Errorf("configuring the Lock Backend: %+v", err)
+		}
+		locks.SetBackend(lockBackend)
+
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
@@ -307,15 +457,27 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
+		configureTags(d.Get("default_tags").([]interface{}), d.Get("ignore_tags").([]interface{}))
+
+		userFeatures := expandFeatures(d.Get("features").([]interface{}))
+		if err := applyDefaultTimeouts(p, userFeatures.DefaultTimeouts); err != nil {
+			return nil, diag.Errorf("configuring the `default_timeouts` within the `features` block: %+v", err)
+		}
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
+			Authorizer:                  authorizer,
+			CacheReadRequests:           d.Get("cache_read_requests").(bool),
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
+			RateLimitReadsPerSecond:     d.Get("rate_limit_reads_per_second").(int),
+			RateLimitWritesPerSecond:    d.Get("rate_limit_writes_per_second").(int),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
-			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			Features:                    userFeatures,
+			LogFormat:                   d.Get("log_format").(string),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
@@ -335,6 +497,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		}
 
 		client.StopContext = stopCtx
+		locks.LogHoldersOnCancel(stopCtx)
 
 		if !skipProviderRegistration {
 			// List all the available providers and their registration state to avoid unnecessary
@@ -358,6 +521,109 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	}
 }
 
+// azureCliClientId is the first party Client ID used when authenticating using the Azure CLI
+const azureCliClientId = "04b07795-8ddb-461a-bbee-02f9e1bf7b46"
+
+// buildAuthConfig builds the Authentication Config using go-azure-helpers - returning an Authorizer for the
+// authentication methods which aren't supported by go-azure-helpers, which is used in place of the Config
+func buildAuthConfig(builder *authentication.Builder, oidcToken, oidcTokenFilePath string, useCliTenant bool) (*authentication.Config, clients.Authorizer, error) {
+	// a Client Certificate or Client Secret takes precedence over OIDC, in the same manner as go-azure-helpers
+	usingClientCredentials := builder.ClientCertPath != "" || builder.ClientSecret != ""
+	if builder.SupportsOIDCAuth && !usingClientCredentials && (oidcToken != "" || oidcTokenFilePath != "") {
+		authorizer := clients.FederatedTokenAuthorizer{
+			AuxiliaryTenantIDs: builder.AuxiliaryTenantIDs,
+			ClientID:           builder.ClientID,
+			TenantID:           builder.TenantID,
+			Token:              oidcToken,
+			TokenFilePath:      oidcTokenFilePath,
+		}
+		if err := authorizer.Validate(); err != nil {
+			return nil, nil, err
+		}
+		if builder.SubscriptionID == "" {
+			return nil, nil, fmt.Errorf("a Subscription ID must be configured when authenticating with OIDC using a Federated Token")
+		}
+
+		config := &authentication.Config{
+			ClientID:                         builder.ClientID,
+			SubscriptionID:                   builder.SubscriptionID,
+			TenantID:                         builder.TenantID,
+			AuxiliaryTenantIDs:               builder.AuxiliaryTenantIDs,
+			Environment:                      builder.Environment,
+			MetadataHost:                     builder.MetadataHost,
+			AuthenticatedAsAServicePrincipal: true,
+			AuthenticatedViaOIDC:             true,
+			UseMicrosoftGraph:                builder.UseMicrosoftGraph,
+		}
+		return config, authorizer, nil
+	}
+
+	config, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// go-azure-helpers obtains tokens from the Azure CLI for the Tenant associated with the Subscription, as such
+	// when opted into (and a Tenant ID is specified) we obtain tokens for that Tenant - allowing the Tenant to be chosen
+	if useCliTenant && builder.TenantID != "" && !config.AuthenticatedAsAServicePrincipal && config.ClientID == azureCliClientId {
+		authorizer := clients.AzureCliTenantAuthorizer{
+			AuxiliaryTenantIDs: config.AuxiliaryTenantIDs,
+			TenantID:           config.TenantID,
+		}
+		return config, authorizer, nil
+	}
+
+	return config, nil, nil
+}
+
+// applyDefaultTimeouts overrides the default timeouts for each resource type specified in the `features` block, which
+// are used both by the Plugin SDK and Typed Resources, since these are exposed through the Resource's schema
+func applyDefaultTimeouts(p *schema.Provider, defaultTimeouts map[string]features.TimeoutFeatures) error {
+	for resourceType, timeouts := range defaultTimeouts {
+		resource, ok := p.ResourcesMap[resourceType]
+		if !ok {
+			return fmt.Errorf("the resource type %q is not supported by this provider", resourceType)
+		}
+
+		if err := pluginsdk.ApplyDefaultTimeouts(resource, timeouts.Create, timeouts.Read, timeouts.Update, timeouts.Delete); err != nil {
+			return fmt.Errorf("applying the default timeouts for %q: %+v", resourceType, err)
+		}
+	}
+
+	return nil
+}
+
+// configureTags configures the `default_tags` which are merged into the tags for every resource and the `ignore_tags`
+// which are ignored when reading the tags for every resource
+func configureTags(defaultTagsRaw []interface{}, ignoreTagsRaw []interface{}) {
+	defaultTags := make(map[string]string)
+	if len(defaultTagsRaw) > 0 && defaultTagsRaw[0] != nil {
+		raw := defaultTagsRaw[0].(map[string]interface{})
+		for k, v := range raw["tags"].(map[string]interface{}) {
+			defaultTags[k] = v.(string)
+		}
+	}
+
+	ignoreKeys := make([]string, 0)
+	ignoreKeyPrefixes := make([]string, 0)
+	if len(ignoreTagsRaw) > 0 && ignoreTagsRaw[0] != nil {
+		raw := ignoreTagsRaw[0].(map[string]interface{})
+		ignoreKeys = *utils.ExpandStringSlice(raw["keys"].(*schema.Set).List())
+		ignoreKeyPrefixes = *utils.ExpandStringSlice(raw["key_prefixes"].(*schema.Set).List())
+	}
+
+	tags.Configure(defaultTags, ignoreKeys, ignoreKeyPrefixes)
+}
+
+func expandLockBackend(backendType string, directory string) (locks.Backend, error) {
+	if backendType == locks.BackendTypeFile {
+		return locks.NewFileBackend(directory)
+	}
+
+	// otherwise we're using the default in-memory backend
+	return nil, nil
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
@@ -379,3 +645,14 @@ More information on the "skip_provider_registration" flag can be found here:
 https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#skip_provider_registration
 
 Original Error: %s`
+
+// errorDiagnostics returns the Diagnostics for an error returned from a Data Source/Resource, including
+// the ARM error (and the Correlation Request ID used) where the error was returned from Azure
+func errorDiagnostics(err error, meta interface{}) diag.Diagnostics {
+	correlationRequestId := ""
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		correlationRequestId = client.CorrelationRequestID
+	}
+
+	return azure.ErrorDiagnostics(err, correlationRequestId)
+}
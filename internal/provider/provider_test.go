@@ -5,6 +5,11 @@ import (
 	"log"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
 func TestProvider(t *testing.T) {
@@ -67,10 +72,10 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 			// every Resource has to have a Create, Read & Destroy timeout
 
 			//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
-			if resource.Timeouts.Create == nil && resource.Create != nil { //nolint:staticcheck
+			if resource.Timeouts.Create == nil && (resource.Create != nil || resource.CreateContext != nil) { //nolint:staticcheck
 				t.Fatalf("Resource %q defines a Create method but no Create Timeout", resourceName)
 			}
-			if resource.Timeouts.Delete == nil && resource.Delete != nil { //nolint:staticcheck
+			if resource.Timeouts.Delete == nil && (resource.Delete != nil || resource.DeleteContext != nil) { //nolint:staticcheck
 				t.Fatalf("Resource %q defines a Delete method but no Delete Timeout", resourceName)
 			}
 			if resource.Timeouts.Read == nil {
@@ -88,7 +93,7 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 			}
 
 			// Optional
-			if resource.Timeouts.Update == nil && resource.Update != nil { //nolint:staticcheck
+			if resource.Timeouts.Update == nil && (resource.Update != nil || resource.UpdateContext != nil) { //nolint:staticcheck
 				t.Fatalf("Resource %q defines a Update method but no Update Timeout", resourceName)
 			}
 		})
@@ -105,3 +110,94 @@ func TestProvider_counts(t *testing.T) {
 	log.Printf("Data Sources: %d", len(provider.DataSourcesMap))
 	log.Printf("Resources: %d", len(provider.ResourcesMap))
 }
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	defaultTimeout := 30 * time.Minute
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"azurerm_example": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: &defaultTimeout,
+					Read:   &defaultTimeout,
+					Delete: &defaultTimeout,
+				},
+			},
+		},
+	}
+
+	err := applyDefaultTimeouts(p, map[string]features.TimeoutFeatures{
+		"azurerm_example": {
+			Create: 2 * time.Hour,
+		},
+	})
+	if err != nil {
+		t.Fatalf("applying default timeouts: %+v", err)
+	}
+
+	timeouts := p.ResourcesMap["azurerm_example"].Timeouts
+	if *timeouts.Create != 2*time.Hour {
+		t.Fatalf("expected the Create timeout to be 2h but got %s", *timeouts.Create)
+	}
+	if *timeouts.Read != defaultTimeout || *timeouts.Delete != defaultTimeout {
+		t.Fatalf("expected the other timeouts to be unchanged")
+	}
+	if defaultTimeout != 30*time.Minute {
+		t.Fatalf("expected the existing timeout not to be modified")
+	}
+
+	err = applyDefaultTimeouts(p, map[string]features.TimeoutFeatures{
+		"azurerm_example": {
+			Update: time.Hour,
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected an error for an operation the resource doesn't support")
+	}
+
+	err = applyDefaultTimeouts(p, map[string]features.TimeoutFeatures{
+		"azurerm_other": {
+			Create: time.Hour,
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected an error for an unsupported resource type")
+	}
+}
+
+func TestBuildAuthConfigFederatedToken(t *testing.T) {
+	builder := &authentication.Builder{
+		ClientID:         "11111111-1111-1111-1111-111111111111",
+		SubscriptionID:   "22222222-2222-2222-2222-222222222222",
+		TenantID:         "00000000-0000-0000-0000-000000000000",
+		Environment:      "public",
+		SupportsOIDCAuth: true,
+	}
+
+	config, authorizer, err := buildAuthConfig(builder, "", "/var/run/secrets/azure/tokens/azure-identity-token", false)
+	if err != nil {
+		t.Fatalf("building auth config: %+v", err)
+	}
+	if !config.AuthenticatedAsAServicePrincipal || !config.AuthenticatedViaOIDC {
+		t.Fatalf("expected the config to be authenticated as a Service Principal via OIDC but got %+v", config)
+	}
+	if config.SubscriptionID != builder.SubscriptionID || config.TenantID != builder.TenantID {
+		t.Fatalf("expected the Subscription and Tenant ID to be set but got %+v", config)
+	}
+
+	federated, ok := authorizer.(clients.FederatedTokenAuthorizer)
+	if !ok {
+		t.Fatalf("expected a FederatedTokenAuthorizer but got %T", authorizer)
+	}
+	if federated.TokenFilePath != "/var/run/secrets/azure/tokens/azure-identity-token" || federated.ClientID != builder.ClientID {
+		t.Fatalf("expected the FederatedTokenAuthorizer to be populated but got %+v", federated)
+	}
+
+	if _, _, err := buildAuthConfig(builder, "federated-token", "/var/run/secrets/azure/tokens/azure-identity-token", false); err == nil {
+		t.Fatalf("expected an error when both a token and a token file path are specified but didn't get one")
+	}
+
+	builder.SubscriptionID = ""
+	if _, _, err := buildAuthConfig(builder, "", "/var/run/secrets/azure/tokens/azure-identity-token", false); err == nil {
+		t.Fatalf("expected an error when the Subscription ID isn't specified but didn't get one")
+	}
+}
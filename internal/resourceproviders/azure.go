@@ -7,8 +7,10 @@ import (
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 )
 
-func availableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*[]string, error) {
-	providerNames := make([]string, 0)
+// AvailableResourceProviders returns the Resource Providers (and the Resource Types within them) which are
+// available within the Subscription
+func AvailableResourceProviders(ctx context.Context, client *resources.ProvidersClient) ([]resources.Provider, error) {
+	output := make([]resources.Provider, 0)
 	providers, err := client.ListComplete(ctx, nil, "")
 	if err != nil {
 		return nil, fmt.Errorf("listing Resource Providers: %+v", err)
@@ -16,7 +18,7 @@ func availableResourceProviders(ctx context.Context, client *resources.Providers
 	for providers.NotDone() {
 		provider := providers.Value()
 		if provider.Namespace != nil {
-			providerNames = append(providerNames, *provider.Namespace)
+			output = append(output, provider)
 		}
 
 		if err := providers.NextWithContext(ctx); err != nil {
@@ -24,5 +26,5 @@ func availableResourceProviders(ctx context.Context, client *resources.Providers
 		}
 	}
 
-	return &providerNames, nil
+	return output, nil
 }
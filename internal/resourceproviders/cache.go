@@ -10,14 +10,37 @@ import (
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
 var cachedResourceProviders *[]string
 
+// cachedSnapshot contains the Locations and Resource Types which are available, either retrieved from the
+// Resource Manager API or the embedded Snapshot - this can be (validly) nil, as such this shouldn't be relied on
+var cachedSnapshot *Snapshot
+
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
-// and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient) {
-	providers, err := availableResourceProviders(ctx, client)
+// and caches them, for used in enhanced validation. When these can't be retrieved (for example when offline)
+// the Snapshot embedded for this Azure Environment is used instead.
+func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient, environmentName string) {
+	providers, err := AvailableResourceProviders(ctx, client)
+	if err == nil {
+		snapshot := NewSnapshot(providers)
+		cacheSnapshot(&snapshot)
+		return
+	}
+	log.Printf("[DEBUG] error retrieving providers: %s. Falling back to the embedded Snapshot for %q", err, environmentName)
+
+	snapshot, err := LoadSnapshot(environmentName)
 	if err != nil {
-		log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
+		log.Printf("[DEBUG] %s. Enhanced validation will be unavailable", err)
 		return
 	}
+	if len(snapshot.ResourceProviders) == 0 {
+		log.Printf("[WARN] The embedded Snapshot for %q doesn't contain any Resource Providers - as such only the Locations can be validated, the Resource Providers and the Locations for each Resource Type won't be", environmentName)
+	}
+	cacheSnapshot(snapshot)
+}
 
-	cachedResourceProviders = providers
+func cacheSnapshot(snapshot *Snapshot) {
+	// a Snapshot may only contain the Locations, in which case the Resource Providers can't be validated
+	if providers := snapshot.ResourceProviderNames(); len(providers) > 0 {
+		cachedResourceProviders = &providers
+	}
+	cachedSnapshot = snapshot
 }
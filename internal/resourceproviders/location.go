@@ -0,0 +1,168 @@
+package resourceproviders
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// probeResourceId is used to determine whether an ID Validation Function accepts any Resource ID, in which
+// case the Resource Type can't be determined from it
+const probeResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Example.Probe/probes/probe1"
+
+// EnhancedValidateLocation validates the Location against the list of Locations supported by this
+// Azure Environment - which are retrieved from the Resource Manager API, or the embedded Snapshot when offline.
+//
+// NOTE: this is best-effort - if neither of these are available we'll fall back to the original approach
+func EnhancedValidateLocation(i interface{}, k string) ([]string, []error) {
+	if !enhancedEnabled || cachedSnapshot == nil || len(cachedSnapshot.Locations) == 0 {
+		return validation.StringIsNotEmpty(i, k)
+	}
+
+	return validateLocation(i, k, cachedSnapshot.Locations, "")
+}
+
+// EnhancedValidateLocationForResourceType returns a validation function which validates the Location against the
+// Locations that the specified Resource Type (e.g. `Microsoft.Compute/virtualMachines`) is available in.
+//
+// NOTE: this falls back to EnhancedValidateLocation when the Resource Type isn't known
+func EnhancedValidateLocationForResourceType(resourceType string) pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		return enhancedValidateLocationForResourceType(i, k, resourceType)
+	}
+}
+
+func enhancedValidateLocationForResourceType(i interface{}, k string, resourceType string) ([]string, []error) {
+	if !enhancedEnabled || cachedSnapshot == nil || resourceType == "" {
+		return EnhancedValidateLocation(i, k)
+	}
+
+	v, ok := cachedSnapshot.ResourceType(resourceType)
+	if !ok || len(v.Locations) == 0 {
+		return EnhancedValidateLocation(i, k)
+	}
+
+	return validateLocation(i, k, v.Locations, resourceType)
+}
+
+// ConfigureLocationValidation updates the `location` field for the specified Resource, when this uses Enhanced
+// Validation, to validate the Location is available for the Resource Type managed by this Resource.
+//
+// The Resource Type is determined (when first validated) by checking which of the available Resource Types
+// are accepted by the ID Validation Function used when importing this Resource.
+func ConfigureLocationValidation(resource *pluginsdk.Resource) {
+	field, ok := resource.Schema["location"]
+	if !ok || field.ValidateFunc == nil || resource.Importer == nil {
+		return
+	}
+
+	if !isEnhancedLocationValidation(field.ValidateFunc) {
+		return
+	}
+
+	idValidationFunc, ok := pluginsdk.ImporterIDValidationFunc(resource.Importer)
+	if !ok {
+		field.ValidateFunc = EnhancedValidateLocation
+		return
+	}
+
+	var once sync.Once
+	var resourceType string
+	field.ValidateFunc = func(i interface{}, k string) ([]string, []error) {
+		if enhancedEnabled && cachedSnapshot != nil {
+			once.Do(func() {
+				resourceType = resourceTypeForIDValidationFunc(*cachedSnapshot, idValidationFunc)
+			})
+		}
+
+		return enhancedValidateLocationForResourceType(i, k, resourceType)
+	}
+}
+
+func isEnhancedLocationValidation(input pluginsdk.SchemaValidateFunc) bool {
+	pointer := reflect.ValueOf(input).Pointer()
+	for _, v := range []pluginsdk.SchemaValidateFunc{location.EnhancedValidate, EnhancedValidateLocation} {
+		if reflect.ValueOf(v).Pointer() == pointer {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceTypeForIDValidationFunc returns the Resource Type accepted by the ID Validation Function, or an empty
+// string when this can't be determined
+func resourceTypeForIDValidationFunc(snapshot Snapshot, validateFunc pluginsdk.IDValidationFunc) (result string) {
+	// the validation functions are expected to return an error rather than panicking, but this is best-effort
+	defer func() {
+		if r := recover(); r != nil {
+			result = ""
+		}
+	}()
+
+	if validateFunc(probeResourceId) == nil {
+		return ""
+	}
+
+	matches := make([]string, 0)
+	for namespace, provider := range snapshot.ResourceProviders {
+		for resourceType, v := range provider.ResourceTypes {
+			if len(v.Locations) == 0 {
+				continue
+			}
+
+			id := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/%s", namespace)
+			for i, segment := range strings.Split(resourceType, "/") {
+				id += fmt.Sprintf("/%s/name%d", segment, i+1)
+			}
+
+			if validateFunc(id) == nil {
+				matches = append(matches, fmt.Sprintf("%s/%s", namespace, resourceType))
+			}
+		}
+	}
+
+	// when multiple Resource Types are accepted we can't determine which is used
+	if len(matches) != 1 {
+		return ""
+	}
+
+	return matches[0]
+}
+
+func validateLocation(i interface{}, k string, locations []string, resourceType string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalizedUserInput := location.Normalize(v)
+	if normalizedUserInput == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	// Some resources use a location named "global".
+	if normalizedUserInput == "global" {
+		return nil, nil
+	}
+
+	for _, loc := range locations {
+		if normalizedUserInput == location.Normalize(loc) {
+			return nil, nil
+		}
+	}
+
+	if resourceType != "" {
+		return nil, []error{
+			fmt.Errorf("%q is not available for the Resource Type %q, the supported Azure Locations are: %q", normalizedUserInput, resourceType, strings.Join(locations, ",")),
+		}
+	}
+
+	return nil, []error{
+		fmt.Errorf("%q was not found in the list of supported Azure Locations: %q", normalizedUserInput, strings.Join(locations, ",")),
+	}
+}
@@ -0,0 +1,161 @@
+package resourceproviders
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testSnapshot() *Snapshot {
+	return &Snapshot{
+		Locations: []string{"eastus", "uksouth", "westeurope"},
+		ResourceProviders: map[string]SnapshotResourceProvider{
+			"Microsoft.Compute": {
+				ResourceTypes: map[string]SnapshotResourceType{
+					"virtualMachines": {
+						APIVersions: []string{"2022-03-01"},
+						Locations:   []string{"eastus", "westeurope"},
+					},
+				},
+			},
+			"Microsoft.Network": {
+				ResourceTypes: map[string]SnapshotResourceType{
+					"virtualNetworks": {
+						APIVersions: []string{"2021-05-01"},
+						Locations:   []string{"uksouth"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func useSnapshotForTest(t *testing.T, snapshot *Snapshot) {
+	enhancedEnabled = true
+	cachedSnapshot = snapshot
+	t.Cleanup(func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		cachedSnapshot = nil
+		cachedResourceProviders = nil
+	})
+}
+
+func TestEnhancedValidateLocation(t *testing.T) {
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "West Europe",
+			valid: true,
+		},
+		{
+			input: "uksouth",
+			valid: true,
+		},
+		{
+			input: "global",
+			valid: true,
+		},
+		{
+			input: "westeurope2",
+			valid: false,
+		},
+	}
+	useSnapshotForTest(t, testSnapshot())
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.input)
+
+		warnings, errors := EnhancedValidateLocation(testCase.input, "location")
+		valid := len(warnings) == 0 && len(errors) == 0
+		if testCase.valid != valid {
+			t.Errorf("Expected %t but got %t", testCase.valid, valid)
+		}
+	}
+}
+
+func TestEnhancedValidateLocationUnavailable(t *testing.T) {
+	useSnapshotForTest(t, nil)
+
+	if _, errors := EnhancedValidateLocation("westeurope2", "location"); len(errors) > 0 {
+		t.Fatalf("expected any Location to be valid without a Snapshot but got %+v", errors)
+	}
+	if _, errors := EnhancedValidateLocation("", "location"); len(errors) == 0 {
+		t.Fatalf("expected an empty Location to be invalid")
+	}
+}
+
+func TestEnhancedValidateLocationForResourceType(t *testing.T) {
+	testCases := []struct {
+		resourceType string
+		input        string
+		valid        bool
+	}{
+		{
+			resourceType: "Microsoft.Compute/virtualMachines",
+			input:        "West Europe",
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.Compute/virtualMachines",
+			input:        "uksouth",
+			valid:        false,
+		},
+		{
+			// unknown Resource Types fall back to the Locations within the Environment
+			resourceType: "Microsoft.Compute/disks",
+			input:        "uksouth",
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.Compute/disks",
+			input:        "westeurope2",
+			valid:        false,
+		},
+	}
+	useSnapshotForTest(t, testSnapshot())
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q for %q..", testCase.input, testCase.resourceType)
+
+		warnings, errors := EnhancedValidateLocationForResourceType(testCase.resourceType)(testCase.input, "location")
+		valid := len(warnings) == 0 && len(errors) == 0
+		if testCase.valid != valid {
+			t.Errorf("Expected %t but got %t", testCase.valid, valid)
+		}
+	}
+}
+
+func TestConfigureLocationValidation(t *testing.T) {
+	useSnapshotForTest(t, testSnapshot())
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+		},
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if !strings.Contains(id, "/providers/Microsoft.Network/virtualNetworks/") || strings.Count(id, "/") != 8 {
+				return fmt.Errorf("expected a Virtual Network ID but got %q", id)
+			}
+			return nil
+		}),
+	}
+	ConfigureLocationValidation(resource)
+
+	validateFunc := resource.Schema["location"].ValidateFunc
+	if _, errors := validateFunc("UK South", "location"); len(errors) > 0 {
+		t.Fatalf("expected the Location to be available for Virtual Networks but got %+v", errors)
+	}
+	if _, errors := validateFunc("westeurope", "location"); len(errors) == 0 {
+		t.Fatalf("expected the Location not to be available for Virtual Networks")
+	}
+}
@@ -0,0 +1,165 @@
+package resourceproviders
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// NOTE: the Snapshots are refreshed using `make resource-provider-snapshots`, which requires credentials for each Environment
+//
+//go:embed snapshots/*.json
+var snapshotFiles embed.FS
+
+// SnapshotEnvironments maps the name of each Environment which has an embedded Snapshot (as used for the
+// `environment` field in the Provider block) to the name of the Azure Environment
+var SnapshotEnvironments = map[string]string{
+	"china":        "AzureChinaCloud",
+	"german":       "AzureGermanCloud",
+	"public":       "AzurePublicCloud",
+	"usgovernment": "AzureUSGovernmentCloud",
+}
+
+// Snapshot is a point-in-time copy of the Locations and Resource Providers available within an Azure Environment,
+// which is embedded into the Provider so that Enhanced Validation is available when these can't be retrieved
+// from the Resource Manager API (for example when offline)
+type Snapshot struct {
+	// Locations is a list of the (normalized) Locations available within this Azure Environment
+	Locations []string `json:"locations"`
+
+	// ResourceProviders is a map of the Resource Provider Namespace to the Resource Types available within it
+	ResourceProviders map[string]SnapshotResourceProvider `json:"resourceProviders"`
+}
+
+type SnapshotResourceProvider struct {
+	// ResourceTypes is a map of the Resource Type (e.g. `virtualMachines` or `servers/databases`) to the
+	// API Versions and Locations which it's available in
+	ResourceTypes map[string]SnapshotResourceType `json:"resourceTypes"`
+}
+
+type SnapshotResourceType struct {
+	APIVersions []string `json:"apiVersions"`
+
+	// Locations is a list of the (normalized) Locations which this Resource Type is available in, where
+	// a Resource Type which isn't tied to a Location (e.g. `global`) has no Locations
+	Locations []string `json:"locations"`
+}
+
+// LoadSnapshot returns the Snapshot embedded for the specified Azure Environment (e.g. `AzurePublicCloud`)
+func LoadSnapshot(environmentName string) (*Snapshot, error) {
+	for name, azureEnvironmentName := range SnapshotEnvironments {
+		if !strings.EqualFold(azureEnvironmentName, environmentName) && !strings.EqualFold(name, environmentName) {
+			continue
+		}
+
+		contents, err := snapshotFiles.ReadFile(fmt.Sprintf("snapshots/%s.json", name))
+		if err != nil {
+			return nil, fmt.Errorf("reading Snapshot for %q: %+v", environmentName, err)
+		}
+
+		var snapshot Snapshot
+		if err := json.Unmarshal(contents, &snapshot); err != nil {
+			return nil, fmt.Errorf("deserializing Snapshot for %q: %+v", environmentName, err)
+		}
+
+		return &snapshot, nil
+	}
+
+	return nil, fmt.Errorf("a Snapshot isn't available for the Environment %q", environmentName)
+}
+
+// NewSnapshot builds a Snapshot from the Resource Providers returned from the Resource Manager API
+func NewSnapshot(providers []resources.Provider) Snapshot {
+	snapshot := Snapshot{
+		Locations:         make([]string, 0),
+		ResourceProviders: make(map[string]SnapshotResourceProvider),
+	}
+
+	locations := make(map[string]struct{})
+	for _, provider := range providers {
+		if provider.Namespace == nil {
+			continue
+		}
+
+		resourceTypes := make(map[string]SnapshotResourceType)
+		if provider.ResourceTypes != nil {
+			for _, resourceType := range *provider.ResourceTypes {
+				if resourceType.ResourceType == nil {
+					continue
+				}
+
+				item := SnapshotResourceType{
+					APIVersions: make([]string, 0),
+					Locations:   make([]string, 0),
+				}
+				if resourceType.APIVersions != nil {
+					item.APIVersions = append(item.APIVersions, *resourceType.APIVersions...)
+					sort.Sort(sort.Reverse(sort.StringSlice(item.APIVersions)))
+				}
+				if resourceType.Locations != nil {
+					for _, v := range *resourceType.Locations {
+						normalized := location.Normalize(v)
+						if normalized == "" || normalized == "global" {
+							continue
+						}
+
+						item.Locations = append(item.Locations, normalized)
+						locations[normalized] = struct{}{}
+					}
+					sort.Strings(item.Locations)
+				}
+
+				resourceTypes[*resourceType.ResourceType] = item
+			}
+		}
+
+		snapshot.ResourceProviders[*provider.Namespace] = SnapshotResourceProvider{
+			ResourceTypes: resourceTypes,
+		}
+	}
+
+	for k := range locations {
+		snapshot.Locations = append(snapshot.Locations, k)
+	}
+	sort.Strings(snapshot.Locations)
+
+	return snapshot
+}
+
+// ResourceProviderNames returns the (sorted) names of the Resource Providers within this Snapshot
+func (s Snapshot) ResourceProviderNames() []string {
+	names := make([]string, 0, len(s.ResourceProviders))
+	for name := range s.ResourceProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResourceType returns the Resource Type (e.g. `Microsoft.Compute/virtualMachines`) from this Snapshot, if it exists
+func (s Snapshot) ResourceType(input string) (*SnapshotResourceType, bool) {
+	namespace, resourceType, ok := strings.Cut(input, "/")
+	if !ok {
+		return nil, false
+	}
+
+	for providerName, provider := range s.ResourceProviders {
+		if !strings.EqualFold(providerName, namespace) {
+			continue
+		}
+
+		for name, v := range provider.ResourceTypes {
+			if strings.EqualFold(name, resourceType) {
+				v := v
+				return &v, true
+			}
+		}
+	}
+
+	return nil, false
+}
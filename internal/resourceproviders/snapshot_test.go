@@ -0,0 +1,115 @@
+package resourceproviders
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestLoadSnapshot(t *testing.T) {
+	for name, environmentName := range SnapshotEnvironments {
+		for _, input := range []string{name, environmentName} {
+			t.Logf("[DEBUG] Testing %q..", input)
+
+			snapshot, err := LoadSnapshot(input)
+			if err != nil {
+				t.Fatalf("loading Snapshot for %q: %+v", input, err)
+			}
+			if len(snapshot.Locations) == 0 {
+				t.Fatalf("expected the Snapshot for %q to contain Locations", input)
+			}
+		}
+	}
+
+	if _, err := LoadSnapshot("AzureStackCloud"); err == nil {
+		t.Fatalf("expected an error when loading a Snapshot for an unknown Environment")
+	}
+}
+
+func TestLoadSnapshotResourceTypes(t *testing.T) {
+	snapshot, err := LoadSnapshot("AzurePublicCloud")
+	if err != nil {
+		t.Fatalf("loading Snapshot: %+v", err)
+	}
+
+	// the embedded Snapshot must be generated using `make resource-provider-snapshots`, since Enhanced Validation
+	// of the Location for each Resource Type is unavailable when it only contains the Locations
+	resourceType, ok := snapshot.ResourceType("Microsoft.Compute/virtualMachines")
+	if !ok {
+		t.Fatalf("expected the Snapshot to contain `Microsoft.Compute/virtualMachines`")
+	}
+	if len(resourceType.Locations) == 0 {
+		t.Fatalf("expected `Microsoft.Compute/virtualMachines` to be available in Locations")
+	}
+	if len(resourceType.APIVersions) == 0 {
+		t.Fatalf("expected `Microsoft.Compute/virtualMachines` to contain API Versions")
+	}
+}
+
+func TestNewSnapshot(t *testing.T) {
+	snapshot := NewSnapshot([]resources.Provider{
+		{
+			Namespace: utils.String("Microsoft.Compute"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: utils.String("virtualMachines"),
+					APIVersions:  &[]string{"2021-03-01", "2022-03-01"},
+					Locations:    &[]string{"West Europe", "East US"},
+				},
+				{
+					ResourceType: utils.String("operations"),
+					APIVersions:  &[]string{"2022-03-01"},
+					Locations:    &[]string{},
+				},
+			},
+		},
+		{
+			Namespace: utils.String("Microsoft.Network"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: utils.String("frontDoors"),
+					APIVersions:  &[]string{"2020-05-01"},
+					Locations:    &[]string{"global"},
+				},
+				{
+					ResourceType: utils.String("virtualNetworks"),
+					APIVersions:  &[]string{"2021-05-01"},
+					Locations:    &[]string{"West Europe", "UK South"},
+				},
+			},
+		},
+		{
+			// this should be ignored
+			Namespace: nil,
+		},
+	})
+
+	if expected := []string{"eastus", "uksouth", "westeurope"}; !reflect.DeepEqual(expected, snapshot.Locations) {
+		t.Fatalf("expected the Locations %+v but got %+v", expected, snapshot.Locations)
+	}
+	if expected := []string{"Microsoft.Compute", "Microsoft.Network"}; !reflect.DeepEqual(expected, snapshot.ResourceProviderNames()) {
+		t.Fatalf("expected the Resource Providers %+v but got %+v", expected, snapshot.ResourceProviderNames())
+	}
+
+	virtualMachines, ok := snapshot.ResourceType("microsoft.compute/VirtualMachines")
+	if !ok {
+		t.Fatalf("expected the Resource Type to be found insensitively")
+	}
+	if expected := []string{"2022-03-01", "2021-03-01"}; !reflect.DeepEqual(expected, virtualMachines.APIVersions) {
+		t.Fatalf("expected the API Versions %+v but got %+v", expected, virtualMachines.APIVersions)
+	}
+	if expected := []string{"eastus", "westeurope"}; !reflect.DeepEqual(expected, virtualMachines.Locations) {
+		t.Fatalf("expected the Locations %+v but got %+v", expected, virtualMachines.Locations)
+	}
+
+	frontDoors, ok := snapshot.ResourceType("Microsoft.Network/frontDoors")
+	if !ok || len(frontDoors.Locations) != 0 {
+		t.Fatalf("expected the global Resource Type to have no Locations but got %+v", frontDoors)
+	}
+
+	if _, ok := snapshot.ResourceType("Microsoft.Compute/disks"); ok {
+		t.Fatalf("expected an unknown Resource Type not to be found")
+	}
+}
@@ -2,6 +2,13 @@ package sdk
 
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +22,70 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a Logger which includes the specified fields
+	// (in addition to any existing fields) in each message
+	WithFields(fields LogFields) Logger
+}
+
+// LogFields is a set of key/value pairs which provide context for a log message
+type LogFields map[string]interface{}
+
+const (
+	// LogFieldCorrelationRequestID is the field containing the `x-ms-correlation-request-id` sent to Azure
+	LogFieldCorrelationRequestID = "correlation_request_id"
+
+	// LogFieldOperation is the field containing the operation being performed, e.g. `create`
+	LogFieldOperation = "operation"
+
+	// LogFieldResourceID is the field containing the ID of the resource the operation is being performed on
+	LogFieldResourceID = "resource_id"
+
+	// LogFieldResourceType is the field containing the Terraform type of the resource, e.g. `azurerm_resource_group`
+	LogFieldResourceType = "resource_type"
+)
+
+const (
+	// LogFormatJSON outputs each log message as a JSON object
+	LogFormatJSON = "json"
+
+	// LogFormatText outputs each log message as plain text
+	LogFormatText = "text"
+)
+
+// LogFormats returns the supported formats for log messages
+func LogFormats() []string {
+	return []string{
+		LogFormatJSON,
+		LogFormatText,
+	}
+}
+
+// NewLogger returns a Logger which outputs log messages in the specified format
+func NewLogger(format string) Logger {
+	if format == LogFormatJSON {
+		return JSONLogger{}
+	}
+
+	return ConsoleLogger{}
+}
+
+// merge returns a new set of LogFields containing both the existing and specified fields,
+// where the specified fields take precedence
+func (f LogFields) merge(fields LogFields) LogFields {
+	out := make(LogFields, len(f)+len(fields))
+	for k, v := range f {
+		out[k] = v
+	}
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out
 }
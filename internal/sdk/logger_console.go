@@ -3,17 +3,32 @@ package sdk
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 )
 
 var _ Logger = ConsoleLogger{}
 
 // ConsoleLogger provides a Logger implementation which writes the log messages
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
-type ConsoleLogger struct{}
+type ConsoleLogger struct {
+	fields LogFields
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l ConsoleLogger) Debug(message string) {
+	l.print("DEBUG", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
 
 // Info prints out a message prefixed with `[INFO]` verbatim
-func (ConsoleLogger) Info(message string) {
-	log.Print(fmt.Sprintf("[INFO] %s", message))
+func (l ConsoleLogger) Info(message string) {
+	l.print("INFO", message)
 }
 
 // Infof prints out a message prefixed with `[INFO]` formatted
@@ -24,7 +39,7 @@ func (l ConsoleLogger) Infof(format string, args ...interface{}) {
 
 // Warn prints out a message prefixed with `[WARN]` formatted verbatim
 func (l ConsoleLogger) Warn(message string) {
-	log.Print(fmt.Sprintf("[WARN] %s", message))
+	l.print("WARN", message)
 }
 
 // Warnf prints out a message prefixed with `[WARN]` formatted
@@ -32,3 +47,44 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l ConsoleLogger) Error(message string) {
+	l.print("ERROR", message)
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a ConsoleLogger which appends the specified fields to each message
+func (l ConsoleLogger) WithFields(fields LogFields) Logger {
+	return ConsoleLogger{
+		fields: l.fields.merge(fields),
+	}
+}
+
+func (l ConsoleLogger) print(level, message string) {
+	log.Print(formatConsoleMessage(level, message, l.fields))
+}
+
+func formatConsoleMessage(level, message string, fields LogFields) string {
+	out := fmt.Sprintf("[%s] %s", level, message)
+	if len(fields) == 0 {
+		return out
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, fmt.Sprint(fields[k])))
+	}
+	return fmt.Sprintf("%s (%s)", out, strings.Join(pairs, " "))
+}
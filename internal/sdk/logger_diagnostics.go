@@ -2,27 +2,77 @@ package sdk
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 var _ Logger = &DiagnosticsLogger{}
 
+// DiagnosticsLogger provides a Logger implementation which surfaces any warnings
+// as Diagnostics to Terraform, in addition to writing each message to the output Logger
 type DiagnosticsLogger struct {
 	diagnostics diag.Diagnostics
+
+	// output is the Logger each message is written to, defaults to the ConsoleLogger when unset
+	output Logger
+
+	// parent is the DiagnosticsLogger which this Logger was created from (using WithFields),
+	// which any Diagnostics are collected on
+	parent *DiagnosticsLogger
+}
+
+// NewDiagnosticsLogger returns a DiagnosticsLogger which writes each message to the specified Logger
+func NewDiagnosticsLogger(output Logger) *DiagnosticsLogger {
+	return &DiagnosticsLogger{
+		output: output,
+	}
+}
+
+func (d *DiagnosticsLogger) Debug(message string) {
+	d.out().Debug(message)
+}
+
+func (d *DiagnosticsLogger) Debugf(format string, args ...interface{}) {
+	d.out().Debugf(format, args...)
 }
 
 func (d *DiagnosticsLogger) Info(message string) {
-	log.Printf("[INFO] %s", message)
+	d.out().Info(message)
 }
 
 func (d *DiagnosticsLogger) Infof(format string, args ...interface{}) {
-	log.Printf("[INFO] "+format, args...)
+	d.out().Infof(format, args...)
 }
 
 func (d *DiagnosticsLogger) Warn(message string) {
-	d.diagnostics = append(d.diagnostics, diag.Diagnostic{
+	d.out().Warn(message)
+	d.appendDiagnostic(message)
+}
+
+func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
+	d.Warn(fmt.Sprintf(format, args...))
+}
+
+func (d *DiagnosticsLogger) Error(message string) {
+	d.out().Error(message)
+}
+
+func (d *DiagnosticsLogger) Errorf(format string, args ...interface{}) {
+	d.out().Errorf(format, args...)
+}
+
+// WithFields returns a DiagnosticsLogger which includes the specified fields in each message written
+// to the output Logger - the Diagnostics are collected on this DiagnosticsLogger
+func (d *DiagnosticsLogger) WithFields(fields LogFields) Logger {
+	return &DiagnosticsLogger{
+		output: d.out().WithFields(fields),
+		parent: d.root(),
+	}
+}
+
+func (d *DiagnosticsLogger) appendDiagnostic(message string) {
+	root := d.root()
+	root.diagnostics = append(root.diagnostics, diag.Diagnostic{
 		Severity:      diag.Warning,
 		Summary:       message,
 		Detail:        message,
@@ -30,11 +80,16 @@ func (d *DiagnosticsLogger) Warn(message string) {
 	})
 }
 
-func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
-	d.diagnostics = append(d.diagnostics, diag.Diagnostic{
-		Severity:      diag.Warning,
-		Summary:       fmt.Sprintf(format, args...),
-		Detail:        fmt.Sprintf(format, args...),
-		AttributePath: nil,
-	})
+func (d *DiagnosticsLogger) out() Logger {
+	if d.output == nil {
+		return ConsoleLogger{}
+	}
+	return d.output
+}
+
+func (d *DiagnosticsLogger) root() *DiagnosticsLogger {
+	if d.parent != nil {
+		return d.parent.root()
+	}
+	return d
 }
@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+var _ Logger = JSONLogger{}
+
+// JSONLogger provides a Logger implementation which writes each log message as a JSON object,
+// prefixed with the log level so that Terraform can continue to filter these by level
+type JSONLogger struct {
+	fields LogFields
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l JSONLogger) Debug(message string) {
+	l.print("DEBUG", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l JSONLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Info prints out a message prefixed with `[INFO]` verbatim
+func (l JSONLogger) Info(message string) {
+	l.print("INFO", message)
+}
+
+// Infof prints out a message prefixed with `[INFO]` formatted
+// with the specified arguments
+func (l JSONLogger) Infof(format string, args ...interface{}) {
+	l.Info(fmt.Sprintf(format, args...))
+}
+
+// Warn prints out a message prefixed with `[WARN]` formatted verbatim
+func (l JSONLogger) Warn(message string) {
+	l.print("WARN", message)
+}
+
+// Warnf prints out a message prefixed with `[WARN]` formatted
+// with the specified arguments
+func (l JSONLogger) Warnf(format string, args ...interface{}) {
+	l.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l JSONLogger) Error(message string) {
+	l.print("ERROR", message)
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l JSONLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a JSONLogger which includes the specified fields in each message
+func (l JSONLogger) WithFields(fields LogFields) Logger {
+	return JSONLogger{
+		fields: l.fields.merge(fields),
+	}
+}
+
+func (l JSONLogger) print(level, message string) {
+	log.Print(formatJSONMessage(level, message, l.fields, time.Now()))
+}
+
+func formatJSONMessage(level, message string, fields LogFields, timestamp time.Time) string {
+	payload := make(map[string]interface{}, len(fields)+3)
+	for k, v := range fields {
+		payload[k] = v
+	}
+	payload["@level"] = strings.ToLower(level)
+	payload["@message"] = message
+	payload["@timestamp"] = timestamp.UTC().Format(time.RFC3339Nano)
+
+	out, err := json.Marshal(payload)
+	if err != nil {
+		// fall back to a plain text message rather than losing the message entirely
+		return formatConsoleMessage(level, message, fields)
+	}
+
+	return fmt.Sprintf("[%s] %s", level, string(out))
+}
@@ -1,10 +1,21 @@
 package sdk
 
+var _ Logger = NullLogger{}
+
 // NullLogger disregards the log output - and is intended to be used
 // when the contents of the debug logger aren't interesting
 // to reduce console output
 type NullLogger struct{}
 
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (NullLogger) Debug(_ string) {
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (NullLogger) Debugf(_ string, _ ...interface{}) {
+}
+
 // Info prints out a message prefixed with `[INFO]` verbatim
 func (NullLogger) Info(_ string) {
 }
@@ -22,3 +33,17 @@ func (NullLogger) Warn(_ string) {
 // with the specified arguments
 func (NullLogger) Warnf(_ string, _ ...interface{}) {
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (NullLogger) Error(_ string) {
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (NullLogger) Errorf(_ string, _ ...interface{}) {
+}
+
+// WithFields returns the NullLogger, since the output is disregarded
+func (l NullLogger) WithFields(_ LogFields) Logger {
+	return l
+}
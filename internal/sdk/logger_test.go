@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatConsoleMessage(t *testing.T) {
+	testData := []struct {
+		fields   LogFields
+		expected string
+	}{
+		{
+			fields:   nil,
+			expected: "[INFO] hello",
+		},
+		{
+			fields: LogFields{
+				LogFieldResourceType: "azurerm_resource_group",
+				LogFieldOperation:    "create",
+			},
+			expected: `[INFO] hello (operation="create" resource_type="azurerm_resource_group")`,
+		},
+	}
+
+	for _, v := range testData {
+		actual := formatConsoleMessage("INFO", "hello", v.fields)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestFormatJSONMessage(t *testing.T) {
+	fields := LogFields{
+		LogFieldResourceID:           "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+		LogFieldCorrelationRequestID: "abc123",
+	}
+	actual := formatJSONMessage("WARN", "hello", fields, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	if !strings.HasPrefix(actual, "[WARN] ") {
+		t.Fatalf("expected the message to be prefixed with the level but got %q", actual)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(actual, "[WARN] ")), &payload); err != nil {
+		t.Fatalf("parsing message: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"@level":                     "warn",
+		"@message":                   "hello",
+		"@timestamp":                 "2021-01-01T00:00:00Z",
+		LogFieldResourceID:           "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+		LogFieldCorrelationRequestID: "abc123",
+	}
+	for k, v := range expected {
+		if payload[k] != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, payload[k])
+		}
+	}
+}
+
+func TestLoggerWithFieldsMerges(t *testing.T) {
+	logger := JSONLogger{}.WithFields(LogFields{
+		LogFieldOperation: "create",
+	}).WithFields(LogFields{
+		LogFieldOperation:  "read",
+		LogFieldResourceID: "example",
+	})
+
+	fields := logger.(JSONLogger).fields
+	if fields[LogFieldOperation] != "read" {
+		t.Fatalf("expected the later value for %q to take precedence but got %q", LogFieldOperation, fields[LogFieldOperation])
+	}
+	if fields[LogFieldResourceID] != "example" {
+		t.Fatalf("expected %q to be set but got %q", LogFieldResourceID, fields[LogFieldResourceID])
+	}
+}
+
+func TestDiagnosticsLoggerWithFieldsCollectsWarnings(t *testing.T) {
+	diagsLogger := NewDiagnosticsLogger(NullLogger{})
+	logger := diagsLogger.WithFields(LogFields{
+		LogFieldOperation: "create",
+	})
+
+	logger.Info("ignored")
+	logger.Warnf("something %s", "happened")
+
+	if len(diagsLogger.diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic but got %d", len(diagsLogger.diagnostics))
+	}
+	if diagsLogger.diagnostics[0].Summary != "something happened" {
+		t.Fatalf("expected the summary to be %q but got %q", "something happened", diagsLogger.diagnostics[0].Summary)
+	}
+}
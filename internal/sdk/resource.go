@@ -83,8 +83,6 @@ type StateUpgradeData struct {
 	Upgraders     map[int]pluginsdk.StateUpgrade
 }
 
-// TODO: a generic state migration for updating ID's
-
 type ResourceWithCustomImporter interface {
 	Resource
 
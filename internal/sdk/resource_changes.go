@@ -0,0 +1,343 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ModelChanges is a list of the `tfschema` paths of the fields within a Model which have changed
+// for example `display_name` or `sku.0.name`
+type ModelChanges []string
+
+// UpdateMappings maps the `tfschema` path of a field within the Model (e.g. `display_name` or
+// `sku.0.name`) to the path of the field within the SDK Payload (e.g. `Properties.DisplayName`)
+type UpdateMappings map[string]string
+
+// DecodeChanges decodes the Terraform Configuration into the specified object (in the same manner
+// as Decode) and returns the `tfschema` paths of the fields which differ from the prior state.
+// Intended for use in Update functions, to only send the fields which have changed.
+//
+// Example Usage:
+//
+// var config Person
+// changes, err := metadata.DecodeChanges(&config)
+// if err != nil { .. }
+// if err := changes.Apply(config, existing.Model, sdk.UpdateMappings{"name": "Properties.Name"}); err != nil { .. }
+func (rmd ResourceMetaData) DecodeChanges(input interface{}) (ModelChanges, error) {
+	if rmd.ResourceData == nil {
+		return nil, fmt.Errorf("ResourceData was nil")
+	}
+	if reflect.TypeOf(input).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("need a pointer")
+	}
+
+	if err := decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger); err != nil {
+		return nil, fmt.Errorf("decoding configuration: %+v", err)
+	}
+
+	prior := reflect.New(reflect.TypeOf(input).Elem())
+	state := priorStateRetriever{
+		resourceData: rmd.ResourceData,
+	}
+	if err := decodeReflectedType(prior.Interface(), state, rmd.serializationDebugLogger); err != nil {
+		return nil, fmt.Errorf("decoding prior state: %+v", err)
+	}
+
+	return changedFields(prior.Elem(), reflect.ValueOf(input).Elem(), "")
+}
+
+// HasChange returns whether the field at the specified `tfschema` path (or any field nested within it) has changed
+func (c ModelChanges) HasChange(path string) bool {
+	for _, v := range c {
+		if v == path || strings.HasPrefix(v, path+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasChanges returns whether any of the fields at the specified `tfschema` paths have changed
+func (c ModelChanges) HasChanges(paths ...string) bool {
+	for _, path := range paths {
+		if c.HasChange(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Apply sets the value of each field within the Model which has changed onto the SDK Payload, using the
+// specified mappings. Pointers within the Payload are allocated as required and values are converted
+// to the type of the field in the Payload - for example a `string` is set into a `*string`, a `map[string]string`
+// into a `*map[string]string` and a `string` into a constant type (such as `Sku`) which is a `string`.
+func (c ModelChanges) Apply(model interface{}, payload interface{}, mappings UpdateMappings) error {
+	if reflect.TypeOf(payload).Kind() != reflect.Ptr || reflect.ValueOf(payload).IsNil() {
+		return fmt.Errorf("need a pointer to the payload")
+	}
+
+	paths := make([]string, 0, len(mappings))
+	for path := range mappings {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if !c.HasChange(path) {
+			continue
+		}
+
+		payloadPath := mappings[path]
+		source, err := modelField(reflect.Indirect(reflect.ValueOf(model)), path)
+		if err != nil {
+			return fmt.Errorf("retrieving %q from the model: %+v", path, err)
+		}
+
+		destination, err := payloadField(reflect.ValueOf(payload).Elem(), payloadPath)
+		if err != nil {
+			return fmt.Errorf("retrieving %q from the payload: %+v", payloadPath, err)
+		}
+
+		if err := assignValue(destination, source); err != nil {
+			return fmt.Errorf("setting %q to %q: %+v", payloadPath, path, err)
+		}
+	}
+
+	return nil
+}
+
+// priorStateRetriever returns the values from the prior state, so that this can be decoded into the Model
+type priorStateRetriever struct {
+	resourceData *schema.ResourceData
+}
+
+func (p priorStateRetriever) Get(key string) interface{} {
+	old, _ := p.resourceData.GetChange(key)
+	return old
+}
+
+func (p priorStateRetriever) GetOk(key string) (interface{}, bool) {
+	old := p.Get(key)
+	return old, old != nil && !reflect.ValueOf(old).IsZero()
+}
+
+func (p priorStateRetriever) GetOkExists(key string) (interface{}, bool) {
+	old := p.Get(key)
+
+	// the Plugin SDK returns the zero value for fields which aren't in the prior state, as such
+	// we check the raw state (where available) so that unset Pointer fields remain nil - falling
+	// back to treating the zero value as unset
+	rawState := p.resourceData.GetRawState()
+	if rawState.IsNull() || !rawState.IsKnown() || !rawState.Type().IsObjectType() || !rawState.Type().HasAttribute(key) {
+		return p.GetOk(key)
+	}
+
+	return old, !rawState.GetAttr(key).IsNull()
+}
+
+func changedFields(prior, config reflect.Value, prefix string) (ModelChanges, error) {
+	if prior.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct but got %s", prior.Kind())
+	}
+
+	changes := make(ModelChanges, 0)
+	for i := 0; i < prior.NumField(); i++ {
+		field := prior.Type().Field(i)
+		tfschemaTag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			continue
+		}
+
+		path := tfschemaTag
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, tfschemaTag)
+		}
+
+		oldVal := prior.Field(i)
+		newVal := config.Field(i)
+
+		// nested blocks containing the same number of items are compared item by item, so that
+		// the path of the changed field within the nested block is returned
+		if isNestedBlock(field.Type) && oldVal.Len() == newVal.Len() {
+			for j := 0; j < oldVal.Len(); j++ {
+				nested, err := changedFields(oldVal.Index(j), newVal.Index(j), fmt.Sprintf("%s.%d", path, j))
+				if err != nil {
+					return nil, err
+				}
+				changes = append(changes, nested...)
+			}
+			continue
+		}
+
+		if !valuesEqual(oldVal, newVal) {
+			changes = append(changes, path)
+		}
+	}
+
+	return changes, nil
+}
+
+func isNestedBlock(input reflect.Type) bool {
+	return input.Kind() == reflect.Slice && input.Elem().Kind() == reflect.Struct && input.Elem() != timeType
+}
+
+func valuesEqual(oldVal, newVal reflect.Value) bool {
+	switch oldVal.Kind() {
+	case reflect.Map, reflect.Slice:
+		// nil and empty are equivalent within the Terraform Schema
+		if oldVal.Len() == 0 && newVal.Len() == 0 {
+			return true
+		}
+	}
+
+	if oldVal.Type() == timeType {
+		return oldVal.Interface().(time.Time).Equal(newVal.Interface().(time.Time))
+	}
+
+	return reflect.DeepEqual(oldVal.Interface(), newVal.Interface())
+}
+
+// modelField returns the field within the Model at the specified `tfschema` path (e.g. `sku.0.name`)
+func modelField(input reflect.Value, path string) (reflect.Value, error) {
+	current := input
+	for _, segment := range strings.Split(path, ".") {
+		switch current.Kind() {
+		case reflect.Struct:
+			found := false
+			for i := 0; i < current.NumField(); i++ {
+				if tag, ok := current.Type().Field(i).Tag.Lookup("tfschema"); ok && tag == segment {
+					current = current.Field(i)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return reflect.Value{}, fmt.Errorf("no field has the `tfschema` tag %q", segment)
+			}
+
+		case reflect.Slice:
+			index, err := strconv.Atoi(segment)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("expected an index but got %q", segment)
+			}
+			if index < 0 || index >= current.Len() {
+				return reflect.Value{}, fmt.Errorf("index %d is out of range (%d items)", index, current.Len())
+			}
+			current = current.Index(index)
+
+		default:
+			return reflect.Value{}, fmt.Errorf("cannot retrieve %q from a %s", segment, current.Kind())
+		}
+	}
+
+	return current, nil
+}
+
+// payloadField returns the field within the Payload at the specified path (e.g. `Properties.DisplayName`)
+// allocating any nil pointers along the way
+func payloadField(input reflect.Value, path string) (reflect.Value, error) {
+	current := input
+	for _, segment := range strings.Split(path, ".") {
+		for current.Kind() == reflect.Ptr {
+			if current.IsNil() {
+				current.Set(reflect.New(current.Type().Elem()))
+			}
+			current = current.Elem()
+		}
+
+		if current.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("cannot retrieve %q from a %s", segment, current.Kind())
+		}
+
+		current = current.FieldByName(segment)
+		if !current.IsValid() {
+			return reflect.Value{}, fmt.Errorf("field %q was not found", segment)
+		}
+	}
+
+	return current, nil
+}
+
+// assignValue sets the value from the Model into the field within the Payload, converting between
+// pointer/non-pointer values and compatible types as required
+func assignValue(destination, source reflect.Value) error {
+	if source.Kind() == reflect.Ptr {
+		if source.IsNil() {
+			destination.Set(reflect.Zero(destination.Type()))
+			return nil
+		}
+		return assignValue(destination, source.Elem())
+	}
+
+	if destination.Kind() == reflect.Ptr {
+		value := reflect.New(destination.Type().Elem())
+		if err := assignValue(value.Elem(), source); err != nil {
+			return err
+		}
+		destination.Set(value)
+		return nil
+	}
+
+	if source.Type().AssignableTo(destination.Type()) {
+		destination.Set(source)
+		return nil
+	}
+
+	switch destination.Kind() {
+	case reflect.String, reflect.Bool:
+		if source.Kind() == destination.Kind() {
+			destination.Set(source.Convert(destination.Type()))
+			return nil
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			destination.Set(source.Convert(destination.Type()))
+			return nil
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch source.Kind() {
+		case reflect.Float32, reflect.Float64:
+			destination.Set(source.Convert(destination.Type()))
+			return nil
+		}
+
+	case reflect.Slice:
+		if source.Kind() == reflect.Slice {
+			value := reflect.MakeSlice(destination.Type(), source.Len(), source.Len())
+			for i := 0; i < source.Len(); i++ {
+				if err := assignValue(value.Index(i), source.Index(i)); err != nil {
+					return fmt.Errorf("item %d: %+v", i, err)
+				}
+			}
+			destination.Set(value)
+			return nil
+		}
+
+	case reflect.Map:
+		if source.Kind() == reflect.Map && source.Type().Key().Kind() == destination.Type().Key().Kind() {
+			value := reflect.MakeMapWithSize(destination.Type(), source.Len())
+			iter := source.MapRange()
+			for iter.Next() {
+				item := reflect.New(destination.Type().Elem()).Elem()
+				if err := assignValue(item, iter.Value()); err != nil {
+					return fmt.Errorf("key %q: %+v", iter.Key().Interface(), err)
+				}
+				value.SetMapIndex(iter.Key().Convert(destination.Type().Key()), item)
+			}
+			destination.Set(value)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot set a %s into a %s", source.Type(), destination.Type())
+}
@@ -0,0 +1,393 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type changesTestInner struct {
+	Name     string `tfschema:"name"`
+	Capacity int    `tfschema:"capacity"`
+}
+
+type changesTestModel struct {
+	Name        string             `tfschema:"name"`
+	DisplayName string             `tfschema:"display_name"`
+	Enabled     bool               `tfschema:"enabled"`
+	Priority    *int64             `tfschema:"priority"`
+	Expires     time.Time          `tfschema:"expires"`
+	Zones       []string           `tfschema:"zones"`
+	Tags        map[string]string  `tfschema:"tags"`
+	Sku         []changesTestInner `tfschema:"sku"`
+}
+
+type changesTestSkuName string
+
+type changesTestPayload struct {
+	Name       *string
+	Properties *changesTestPayloadProperties
+	Sku        *changesTestPayloadSku
+	Tags       map[string]*string
+}
+
+type changesTestPayloadProperties struct {
+	DisplayName *string
+	Enabled     *bool
+	Priority    *int32
+	Zones       *[]string
+}
+
+type changesTestPayloadSku struct {
+	Name     changesTestSkuName
+	Capacity *int64
+}
+
+func TestChangedFields(t *testing.T) {
+	priority := int64(10)
+	expires := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	testData := []struct {
+		Name     string
+		Prior    changesTestModel
+		Config   changesTestModel
+		Expected ModelChanges
+	}{
+		{
+			Name:     "Empty",
+			Expected: ModelChanges{},
+		},
+		{
+			Name: "Nil and Empty Collections",
+			Prior: changesTestModel{
+				Zones: []string{},
+				Tags:  map[string]string{},
+				Sku:   []changesTestInner{},
+			},
+			Expected: ModelChanges{},
+		},
+		{
+			Name: "No Changes",
+			Prior: changesTestModel{
+				Name:     "example",
+				Priority: &priority,
+				Expires:  expires,
+				Zones:    []string{"1", "2"},
+				Tags: map[string]string{
+					"hello": "world",
+				},
+			},
+			Config: changesTestModel{
+				Name:     "example",
+				Priority: &priority,
+				Expires:  expires.In(time.FixedZone("Example", 3600)),
+				Zones:    []string{"1", "2"},
+				Tags: map[string]string{
+					"hello": "world",
+				},
+			},
+			Expected: ModelChanges{},
+		},
+		{
+			Name: "Top Level Changes",
+			Prior: changesTestModel{
+				Name:        "example",
+				DisplayName: "Example",
+				Zones:       []string{"1"},
+				Tags: map[string]string{
+					"hello": "world",
+				},
+			},
+			Config: changesTestModel{
+				Name:        "example",
+				DisplayName: "Updated",
+				Enabled:     true,
+				Priority:    &priority,
+				Expires:     expires,
+				Zones:       []string{"1", "2"},
+			},
+			Expected: ModelChanges{"display_name", "enabled", "priority", "expires", "zones", "tags"},
+		},
+		{
+			Name: "Nested Change",
+			Prior: changesTestModel{
+				Sku: []changesTestInner{
+					{
+						Name:     "Standard",
+						Capacity: 1,
+					},
+				},
+			},
+			Config: changesTestModel{
+				Sku: []changesTestInner{
+					{
+						Name:     "Standard",
+						Capacity: 2,
+					},
+				},
+			},
+			Expected: ModelChanges{"sku.0.capacity"},
+		},
+		{
+			Name: "Nested Block Added",
+			Config: changesTestModel{
+				Sku: []changesTestInner{
+					{
+						Name: "Standard",
+					},
+				},
+			},
+			Expected: ModelChanges{"sku"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := changedFields(reflect.ValueOf(v.Prior), reflect.ValueOf(v.Config), "")
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestModelChangesHasChange(t *testing.T) {
+	changes := ModelChanges{"display_name", "sku.0.capacity"}
+	testData := map[string]bool{
+		"display_name":   true,
+		"display":        false,
+		"sku":            true,
+		"sku.0":          true,
+		"sku.0.capacity": true,
+		"sku.0.name":     false,
+		"tags":           false,
+	}
+	for path, expected := range testData {
+		if actual := changes.HasChange(path); actual != expected {
+			t.Fatalf("expected HasChange(%q) to be %t but got %t", path, expected, actual)
+		}
+	}
+
+	if !changes.HasChanges("tags", "display_name") {
+		t.Fatalf("expected HasChanges to be true but got false")
+	}
+	if changes.HasChanges("tags", "name") {
+		t.Fatalf("expected HasChanges to be false but got true")
+	}
+}
+
+func TestModelChangesApply(t *testing.T) {
+	priority := int64(10)
+	model := changesTestModel{
+		Name:        "example",
+		DisplayName: "Updated",
+		Enabled:     true,
+		Priority:    &priority,
+		Zones:       []string{"1", "2"},
+		Tags: map[string]string{
+			"hello": "world",
+		},
+		Sku: []changesTestInner{
+			{
+				Name:     "Premium",
+				Capacity: 2,
+			},
+		},
+	}
+	existingName := "example"
+	existingDisplayName := "Example"
+	payload := changesTestPayload{
+		Name: &existingName,
+		Properties: &changesTestPayloadProperties{
+			DisplayName: &existingDisplayName,
+		},
+	}
+	changes := ModelChanges{"display_name", "enabled", "priority", "zones", "tags", "sku.0.name", "sku.0.capacity"}
+	mappings := UpdateMappings{
+		"name":           "Name",
+		"display_name":   "Properties.DisplayName",
+		"enabled":        "Properties.Enabled",
+		"priority":       "Properties.Priority",
+		"zones":          "Properties.Zones",
+		"tags":           "Tags",
+		"sku.0.name":     "Sku.Name",
+		"sku.0.capacity": "Sku.Capacity",
+	}
+	if err := changes.Apply(model, &payload, mappings); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if payload.Name != &existingName {
+		t.Fatalf("expected `Name` to be unchanged")
+	}
+	if payload.Properties.DisplayName == nil || *payload.Properties.DisplayName != "Updated" {
+		t.Fatalf("expected `Properties.DisplayName` to be `Updated` but got %+v", payload.Properties.DisplayName)
+	}
+	if payload.Properties.Enabled == nil || !*payload.Properties.Enabled {
+		t.Fatalf("expected `Properties.Enabled` to be `true` but got %+v", payload.Properties.Enabled)
+	}
+	if payload.Properties.Priority == nil || *payload.Properties.Priority != 10 {
+		t.Fatalf("expected `Properties.Priority` to be `10` but got %+v", payload.Properties.Priority)
+	}
+	if payload.Properties.Zones == nil || !reflect.DeepEqual(*payload.Properties.Zones, []string{"1", "2"}) {
+		t.Fatalf("expected `Properties.Zones` to be `[1 2]` but got %+v", payload.Properties.Zones)
+	}
+	if v, ok := payload.Tags["hello"]; !ok || v == nil || *v != "world" {
+		t.Fatalf("expected `Tags` to contain `hello` but got %+v", payload.Tags)
+	}
+	if payload.Sku == nil || payload.Sku.Name != "Premium" {
+		t.Fatalf("expected `Sku.Name` to be `Premium` but got %+v", payload.Sku)
+	}
+	if payload.Sku.Capacity == nil || *payload.Sku.Capacity != 2 {
+		t.Fatalf("expected `Sku.Capacity` to be `2` but got %+v", payload.Sku.Capacity)
+	}
+}
+
+func TestModelChangesApplyInvalid(t *testing.T) {
+	model := changesTestModel{
+		DisplayName: "Updated",
+	}
+	testData := map[string]UpdateMappings{
+		"Unknown Model Field": {
+			"description": "Properties.DisplayName",
+		},
+		"Unknown Payload Field": {
+			"display_name": "Properties.Description",
+		},
+		"Mismatched Types": {
+			"display_name": "Properties.Enabled",
+		},
+	}
+	for name, mappings := range testData {
+		t.Logf("[DEBUG] Testing %q", name)
+
+		payload := changesTestPayload{}
+		if err := (ModelChanges{"description", "display_name"}).Apply(model, &payload, mappings); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+
+	if err := (ModelChanges{"display_name"}).Apply(model, changesTestPayload{}, UpdateMappings{}); err == nil {
+		t.Fatalf("expected an error when the payload isn't a pointer but didn't get one")
+	}
+}
+
+func TestDecodeChanges(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"expires": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"zones": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"sku": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"capacity": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "some-id",
+		Attributes: map[string]string{
+			"id":             "some-id",
+			"name":           "example",
+			"display_name":   "Example",
+			"enabled":        "true",
+			"zones.#":        "1",
+			"zones.0":        "1",
+			"tags.%":         "1",
+			"tags.hello":     "world",
+			"sku.#":          "1",
+			"sku.0.name":     "Standard",
+			"sku.0.capacity": "1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":         "example",
+		"display_name": "Updated",
+		"enabled":      true,
+		"zones":        []interface{}{"1"},
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+		"sku": []interface{}{
+			map[string]interface{}{
+				"name":     "Standard",
+				"capacity": 2,
+			},
+		},
+	})
+
+	diff, err := schema.InternalMap(s).Diff(context.TODO(), state, config, nil, nil, true)
+	if err != nil {
+		t.Fatalf("building diff: %+v", err)
+	}
+	resourceData, err := schema.InternalMap(s).Data(state, diff)
+	if err != nil {
+		t.Fatalf("building resource data: %+v", err)
+	}
+
+	metadata := ResourceMetaData{
+		ResourceData:             resourceData,
+		Logger:                   ConsoleLogger{},
+		serializationDebugLogger: ConsoleLogger{},
+	}
+	var model changesTestModel
+	changes, err := metadata.DecodeChanges(&model)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := ModelChanges{"display_name", "sku.0.capacity"}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Fatalf("expected %+v but got %+v", expected, changes)
+	}
+	if model.DisplayName != "Updated" || len(model.Sku) != 1 || model.Sku[0].Capacity != 2 {
+		t.Fatalf("expected the configuration to be decoded into the model but got %+v", model)
+	}
+}
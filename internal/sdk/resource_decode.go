@@ -1,8 +1,10 @@
 package sdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -42,176 +44,228 @@ type stateRetriever interface {
 	GetOkExists(key string) (interface{}, bool)
 }
 
-func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
+func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) (errOut error) {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
 	}
 
+	defer func() {
+		if r := recover(); r != nil {
+			debugLogger.Warnf("error decoding model: %+v", r)
+			out, ok := r.(error)
+			if !ok {
+				return
+			}
+
+			errOut = out
+		}
+	}()
+
 	objType := reflect.TypeOf(input).Elem()
+	objVal := reflect.ValueOf(input).Elem()
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
 		debugLogger.Infof("Field", field)
 
 		if val, exists := field.Tag.Lookup("tfschema"); exists {
+			// NOTE: fields which aren't set are skipped, meaning that Pointer fields remain nil
+			// which allows an unset value to be told apart from the zero value
 			tfschemaValue, valExists := stateRetriever.GetOkExists(val)
 			if !valExists {
 				continue
 			}
 
 			debugLogger.Infof("TFSchemaValue: ", tfschemaValue)
-			debugLogger.Infof("Input Type: ", reflect.ValueOf(input).Elem().Field(i).Type())
+			debugLogger.Infof("Input Type: ", objVal.Field(i).Type())
 
-			fieldName := reflect.ValueOf(input).Elem().Field(i).String()
-			if err := setValue(input, tfschemaValue, i, fieldName, debugLogger); err != nil {
-				return fmt.Errorf("while setting value %+v of model field %q: %+v", tfschemaValue, fieldName, err)
+			if err := setValue(objVal.Field(i), tfschemaValue, val, debugLogger); err != nil {
+				return err
 			}
 		}
 	}
 	return nil
 }
 
-func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
-	debugLogger.Infof("setting list value for %q..", fieldName)
-	defer func() {
-		if r := recover(); r != nil {
-			debugLogger.Warnf("error setting value for %q: %+v", fieldName, r)
-			out, ok := r.(error)
-			if !ok {
-				return
-			}
-
-			errOut = out
-		}
-	}()
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
 
-	if v, ok := tfschemaValue.(string); ok {
-		debugLogger.Infof("[String] Decode %+v", v)
-		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-		debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
-		reflect.ValueOf(input).Elem().Field(index).SetString(v)
+// setValue sets the value from the Terraform Schema into the field, where path is the
+// path to this field within the Terraform Schema (e.g. `block.0.field`) used in errors
+func setValue(field reflect.Value, tfschemaValue interface{}, path string, debugLogger Logger) error {
+	debugLogger.Infof("setting value for %q..", path)
+	if tfschemaValue == nil {
 		return nil
 	}
 
-	if v, ok := tfschemaValue.(int); ok {
-		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+	switch field.Type() {
+	case timeType:
+		v, ok := tfschemaValue.(string)
+		if !ok {
+			return fmt.Errorf("decoding %q: expected a string containing an RFC3339 timestamp but got %T", path, tfschemaValue)
+		}
+		if v == "" {
+			return nil
+		}
+
+		debugLogger.Infof("[TIME] Decode %+v", v)
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("decoding %q: parsing %q as an RFC3339 timestamp: %+v", path, v, err)
+		}
+		field.Set(reflect.ValueOf(t))
 		return nil
-	}
 
-	if v, ok := tfschemaValue.(int32); ok {
-		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+	case rawMessageType:
+		v, ok := tfschemaValue.(string)
+		if !ok {
+			return fmt.Errorf("decoding %q: expected a string containing JSON but got %T", path, tfschemaValue)
+		}
+		if v == "" {
+			return nil
+		}
+
+		debugLogger.Infof("[JSON] Decode %+v", v)
+		if !json.Valid([]byte(v)) {
+			return fmt.Errorf("decoding %q: expected a string containing JSON but got %q", path, v)
+		}
+		field.Set(reflect.ValueOf(json.RawMessage(v)))
 		return nil
 	}
 
-	if v, ok := tfschemaValue.(int64); ok {
+	switch field.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(field.Type().Elem())
+		if err := setValue(elem.Elem(), tfschemaValue, path, debugLogger); err != nil {
+			return err
+		}
+		field.Set(elem)
+
+	case reflect.String:
+		v, ok := tfschemaValue.(string)
+		if !ok {
+			return fmt.Errorf("decoding %q: expected a string but got %T", path, tfschemaValue)
+		}
+		debugLogger.Infof("[String] Decode %+v", v)
+		field.SetString(v)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var v int64
+		switch iv := tfschemaValue.(type) {
+		case int:
+			v = int64(iv)
+		case int32:
+			v = int64(iv)
+		case int64:
+			v = iv
+		default:
+			return fmt.Errorf("decoding %q: expected an integer but got %T", path, tfschemaValue)
+		}
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(v)
-		return nil
-	}
+		field.SetInt(v)
 
-	if v, ok := tfschemaValue.(float64); ok {
+	case reflect.Float32, reflect.Float64:
+		v, ok := tfschemaValue.(float64)
+		if !ok {
+			return fmt.Errorf("decoding %q: expected a float but got %T", path, tfschemaValue)
+		}
 		debugLogger.Infof("[Float] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetFloat(v)
-		return nil
-	}
+		field.SetFloat(v)
 
-	// Doesn't work for empty bools?
-	if v, ok := tfschemaValue.(bool); ok {
+	case reflect.Bool:
+		v, ok := tfschemaValue.(bool)
+		if !ok {
+			return fmt.Errorf("decoding %q: expected a bool but got %T", path, tfschemaValue)
+		}
 		debugLogger.Infof("[BOOL] Decode %+v", v)
+		field.SetBool(v)
 
-		reflect.ValueOf(input).Elem().Field(index).SetBool(v)
-		return nil
-	}
-
-	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
-	}
+	case reflect.Map:
+		mapConfig, ok := tfschemaValue.(map[string]interface{})
+		if !ok {
+			return nil
+		}
 
-	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
-		mapOutput := reflect.MakeMap(reflect.ValueOf(input).Elem().Field(index).Type())
+		mapOutput := reflect.MakeMap(field.Type())
 		for key, val := range mapConfig {
-			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if field.Type().Elem().Kind() == reflect.Interface {
+				elem.Set(reflect.ValueOf(val))
+			} else if err := setValue(elem, val, fmt.Sprintf("%s.%s", path, key), debugLogger); err != nil {
+				return err
+			}
+			mapOutput.SetMapIndex(reflect.ValueOf(key), elem)
 		}
+		field.Set(mapOutput)
 
-		reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
-		return nil
-	}
+	case reflect.Slice:
+		if v, ok := tfschemaValue.(*schema.Set); ok {
+			return setListValue(field, v.List(), path, debugLogger)
+		}
+		if v, ok := tfschemaValue.([]interface{}); ok {
+			return setListValue(field, v, path, debugLogger)
+		}
 
-	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+	case reflect.Struct:
+		v, ok := tfschemaValue.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("decoding %q: expected a block but got %T", path, tfschemaValue)
+		}
+		return setStructValue(field, v, path, debugLogger)
 	}
 
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
-	switch fieldType := reflect.ValueOf(input).Elem().Field(index).Type(); fieldType {
-	case reflect.TypeOf([]string{}):
-		stringSlice := reflect.MakeSlice(reflect.TypeOf([]string{}), len(v), len(v))
-		for i, stringVal := range v {
-			stringSlice.Index(i).SetString(stringVal.(string))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(stringSlice)
+// setListValue sets the items from a List or Set within the Terraform Schema into the slice field
+func setListValue(field reflect.Value, v []interface{}, path string, debugLogger Logger) error {
+	elemType := field.Type().Elem()
+	if elemType.Kind() == reflect.Struct && elemType != timeType {
+		// nested blocks which contain no values are returned as nil, so are omitted
+		valueToSet := reflect.MakeSlice(field.Type(), 0, len(v))
+		debugLogger.Infof("List Type", valueToSet.Type())
 
-	case reflect.TypeOf([]int{}):
-		iSlice := reflect.MakeSlice(reflect.TypeOf([]int{}), len(v), len(v))
-		for i, iVal := range v {
-			iSlice.Index(i).SetInt(int64(iVal.(int)))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(iSlice)
+		for i, item := range v {
+			nestedConfig, ok := item.(map[string]interface{})
+			if !ok || nestedConfig == nil {
+				continue
+			}
 
-	case reflect.TypeOf([]float64{}):
-		fSlice := reflect.MakeSlice(reflect.TypeOf([]float64{}), len(v), len(v))
-		for i, fVal := range v {
-			fSlice.Index(i).SetFloat(fVal.(float64))
+			elem := reflect.New(elemType).Elem()
+			if err := setStructValue(elem, nestedConfig, fmt.Sprintf("%s.%d", path, i), debugLogger); err != nil {
+				return err
+			}
+			valueToSet = reflect.Append(valueToSet, elem)
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(fSlice)
 
-	case reflect.TypeOf([]bool{}):
-		bSlice := reflect.MakeSlice(reflect.TypeOf([]bool{}), len(v), len(v))
-		for i, bVal := range v {
-			bSlice.Index(i).SetBool(bVal.(bool))
-		}
-		reflect.ValueOf(input).Elem().Field(index).Set(bSlice)
+		field.Set(valueToSet)
+		return nil
+	}
 
-	default:
-		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
-		debugLogger.Infof("List Type", valueToSet.Type())
+	valueToSet := reflect.MakeSlice(field.Type(), len(v), len(v))
+	for i, item := range v {
+		if err := setValue(valueToSet.Index(i), item, fmt.Sprintf("%s.%d", path, i), debugLogger); err != nil {
+			return err
+		}
+	}
+	field.Set(valueToSet)
+	return nil
+}
 
-		for _, mapVal := range v {
-			if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
-				elem := reflect.New(fieldType.Elem())
-				debugLogger.Infof("element ", elem)
-				for j := 0; j < elem.Type().Elem().NumField(); j++ {
-					nestedField := elem.Type().Elem().Field(j)
-					debugLogger.Infof("nestedField ", nestedField)
-
-					if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
-						nestedTFSchemaValue := test[val]
-						if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-							return err
-						}
-					}
-				}
-
-				if !elem.CanSet() {
-					elem = elem.Elem()
-				}
-
-				if valueToSet.Kind() == reflect.Ptr {
-					valueToSet.Elem().Set(reflect.Append(valueToSet.Elem(), elem))
-				} else {
-					valueToSet = reflect.Append(valueToSet, elem)
-				}
-
-				debugLogger.Infof("value to set type after changes", valueToSet.Type())
+// setStructValue sets each of the `tfschema` tagged fields within the nested struct
+func setStructValue(field reflect.Value, v map[string]interface{}, path string, debugLogger Logger) error {
+	for i := 0; i < field.NumField(); i++ {
+		nestedField := field.Type().Field(i)
+		debugLogger.Infof("nestedField ", nestedField)
+
+		if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
+			// NOTE: the Plugin SDK returns the zero value for nested fields which aren't set, as
+			// such Pointer fields within nested blocks are only nil when the key isn't present
+			if err := setValue(field.Field(i), v[val], fmt.Sprintf("%s.%s", path, val), debugLogger); err != nil {
+				return err
 			}
 		}
-
-		valueToSet = reflect.Indirect(valueToSet)
-		fieldToSet := reflect.ValueOf(input).Elem().Field(index)
-		fieldToSet.Set(reflect.Indirect(valueToSet))
 	}
 
 	return nil
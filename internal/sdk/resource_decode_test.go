@@ -1,8 +1,13 @@
 package sdk
 
 import (
+	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type decodeTestData struct {
@@ -698,6 +703,191 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestDecode_TopLevelFieldsPointers(t *testing.T) {
+	type SimpleType struct {
+		String    *string  `tfschema:"string"`
+		Number    *int64   `tfschema:"number"`
+		Price     *float64 `tfschema:"price"`
+		Enabled   *bool    `tfschema:"enabled"`
+		Unset     *string  `tfschema:"unset"`
+		ZeroValue *int64   `tfschema:"zero_value"`
+	}
+	str := "world"
+	number := int64(42)
+	price := 129.99
+	enabled := false
+	zero := int64(0)
+	decodeTestData{
+		State: map[string]interface{}{
+			"string":     "world",
+			"number":     42,
+			"price":      129.99,
+			"enabled":    false,
+			"zero_value": 0,
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			String:    &str,
+			Number:    &number,
+			Price:     &price,
+			Enabled:   &enabled,
+			ZeroValue: &zero,
+		},
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsTimeAndJSON(t *testing.T) {
+	type SimpleType struct {
+		Created      time.Time       `tfschema:"created"`
+		Unset        time.Time       `tfschema:"unset"`
+		Settings     json.RawMessage `tfschema:"settings"`
+		UnsetJSON    json.RawMessage `tfschema:"unset_json"`
+		OptionalTime *time.Time      `tfschema:"optional_time"`
+	}
+	optionalTime := time.Date(2022, 6, 7, 8, 9, 10, 0, time.UTC)
+	decodeTestData{
+		State: map[string]interface{}{
+			"created":       "2022-01-02T03:04:05Z",
+			"unset":         "",
+			"settings":      `{"hello":"world"}`,
+			"unset_json":    "",
+			"optional_time": "2022-06-07T08:09:10Z",
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Created:      time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+			Settings:     json.RawMessage(`{"hello":"world"}`),
+			OptionalTime: &optionalTime,
+		},
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsInvalid(t *testing.T) {
+	type SimpleType struct {
+		Created time.Time `tfschema:"created"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"created": "yesterday",
+		},
+		Input:       &SimpleType{},
+		ExpectError: true,
+	}.test(t)
+
+	type OtherType struct {
+		Settings json.RawMessage `tfschema:"settings"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"settings": `{"hello":`,
+		},
+		Input:       &OtherType{},
+		ExpectError: true,
+	}.test(t)
+
+	type MismatchedType struct {
+		Number int `tfschema:"number"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"number": "42",
+		},
+		Input:       &MismatchedType{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedSetOfBlocks(t *testing.T) {
+	type Inner struct {
+		Name     string          `tfschema:"name"`
+		Priority *int64          `tfschema:"priority"`
+		Expires  time.Time       `tfschema:"expires"`
+		Tags     []string        `tfschema:"tags"`
+		Policy   json.RawMessage `tfschema:"policy"`
+	}
+	type Type struct {
+		Rules []Inner `tfschema:"rule"`
+	}
+	hashes := map[string]int{
+		"first":  1,
+		"second": 2,
+	}
+	rules := schema.NewSet(func(input interface{}) int {
+		return hashes[input.(map[string]interface{})["name"].(string)]
+	}, []interface{}{
+		map[string]interface{}{
+			"name":    "second",
+			"expires": "",
+			"tags":    schema.NewSet(schema.HashString, []interface{}{}),
+			"policy":  "",
+		},
+		map[string]interface{}{
+			"name":     "first",
+			"priority": 10,
+			"expires":  "2022-01-02T03:04:05Z",
+			"tags":     schema.NewSet(schema.HashString, []interface{}{"hello"}),
+			"policy":   "[]",
+		},
+	})
+	priority := int64(10)
+	decodeTestData{
+		State: map[string]interface{}{
+			"rule": rules,
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Rules: []Inner{
+				{
+					Name:     "first",
+					Priority: &priority,
+					Expires:  time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+					Tags:     []string{"hello"},
+					Policy:   json.RawMessage(`[]`),
+				},
+				{
+					Name: "second",
+					Tags: []string{},
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedErrorIncludesPath(t *testing.T) {
+	type ThirdInner struct {
+		Expires time.Time `tfschema:"expires"`
+	}
+	type SecondInner struct {
+		Third []ThirdInner `tfschema:"third"`
+	}
+	type Type struct {
+		Second []SecondInner `tfschema:"second"`
+	}
+	state := testDataGetter{
+		values: map[string]interface{}{
+			"second": []interface{}{
+				map[string]interface{}{
+					"third": []interface{}{
+						map[string]interface{}{
+							"expires": "2022-01-02T03:04:05Z",
+						},
+						map[string]interface{}{
+							"expires": "tomorrow",
+						},
+					},
+				},
+			},
+		},
+	}
+	err := decodeReflectedType(&Type{}, state, ConsoleLogger{})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one!")
+	}
+	if !strings.Contains(err.Error(), `"second.0.third.1.expires"`) {
+		t.Fatalf("expected the error to contain the path `second.0.third.1.expires` but got: %+v", err)
+	}
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
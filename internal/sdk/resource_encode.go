@@ -1,8 +1,10 @@
 package sdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 )
 
 // Encode will encode the specified object into the Terraform State
@@ -16,8 +18,7 @@ func (rmd ResourceMetaData) Encode(input interface{}) error {
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 
-	fieldName := reflect.ValueOf(input).Elem().String()
-	serialized, err := recurse(objType, objVal, fieldName, rmd.serializationDebugLogger)
+	serialized, err := recurse(objType, objVal, "", rmd.serializationDebugLogger)
 	if err != nil {
 		return err
 	}
@@ -31,6 +32,8 @@ func (rmd ResourceMetaData) Encode(input interface{}) error {
 	return nil
 }
 
+// recurse encodes each of the `tfschema` tagged fields within the struct, where fieldName
+// is the path to this struct within the Terraform Schema (e.g. `block.0`) used in errors
 func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debugLogger Logger) (output map[string]interface{}, errOut error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -49,93 +52,134 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
 		if tfschemaTag, exists := field.Tag.Lookup("tfschema"); exists {
-			switch field.Type.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				iv := fieldVal.Int()
-				debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
-				output[tfschemaTag] = iv
-
-			case reflect.Float32, reflect.Float64:
-				fv := fieldVal.Float()
-				debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
-				output[tfschemaTag] = fv
-
-			case reflect.String:
-				sv := fieldVal.String()
-				debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
-				output[tfschemaTag] = sv
-
-			case reflect.Bool:
-				bv := fieldVal.Bool()
-				debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
-				output[tfschemaTag] = bv
-
-			case reflect.Map:
-				iter := fieldVal.MapRange()
-				attr := make(map[string]interface{})
-				for iter.Next() {
-					attr[iter.Key().String()] = iter.Value().Interface()
-				}
-				output[tfschemaTag] = attr
-
-			case reflect.Slice:
-				sv := fieldVal.Slice(0, fieldVal.Len())
-				attr := make([]interface{}, sv.Len())
-				switch sv.Type() {
-				case reflect.TypeOf([]string{}):
-					debugLogger.Infof("Setting %q to []string", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]string, 0)
-					}
-
-				case reflect.TypeOf([]int{}):
-					debugLogger.Infof("Setting %q to []int", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]int, 0)
-					}
-
-				case reflect.TypeOf([]float64{}):
-					debugLogger.Infof("Setting %q to []float64", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]float64, 0)
-					}
-
-				case reflect.TypeOf([]bool{}):
-					debugLogger.Infof("Setting %q to []bool", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]bool, 0)
-					}
-
-				default:
-					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
-
-						fieldName := field.Name
-						serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
-						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), exists)
-						}
-						attr[i] = serialized
-					}
-					debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
-					output[tfschemaTag] = attr
-				}
-			default:
-				return output, fmt.Errorf("unknown type %+v for key %q", field.Type.Kind(), tfschemaTag)
+			path := tfschemaTag
+			if fieldName != "" {
+				path = fmt.Sprintf("%s.%s", fieldName, tfschemaTag)
+			}
+
+			value, err := encodeValue(field.Type, fieldVal, path, debugLogger)
+			if err != nil {
+				return output, err
 			}
+			output[tfschemaTag] = value
 		}
 	}
 
 	return output, nil
 }
+
+func encodeValue(fieldType reflect.Type, fieldVal reflect.Value, path string, debugLogger Logger) (interface{}, error) {
+	switch fieldType {
+	case reflect.TypeOf(time.Time{}):
+		t := fieldVal.Interface().(time.Time)
+		debugLogger.Infof("Setting %q to %s", path, t)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(time.RFC3339), nil
+
+	case reflect.TypeOf(json.RawMessage{}):
+		raw := fieldVal.Interface().(json.RawMessage)
+		debugLogger.Infof("Setting %q to %s", path, string(raw))
+		if len(raw) > 0 && !json.Valid(raw) {
+			return nil, fmt.Errorf("encoding %q: expected valid JSON but got %q", path, string(raw))
+		}
+		return string(raw), nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Ptr:
+		// Pointer fields which are nil are encoded as nil, which the Plugin SDK sets as the zero value
+		if fieldVal.IsNil() {
+			debugLogger.Infof("Setting %q to nil", path)
+			return nil, nil
+		}
+		return encodeValue(fieldType.Elem(), fieldVal.Elem(), path, debugLogger)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv := fieldVal.Int()
+		debugLogger.Infof("Setting %q to %d", path, iv)
+		return iv, nil
+
+	case reflect.Float32, reflect.Float64:
+		fv := fieldVal.Float()
+		debugLogger.Infof("Setting %q to %f", path, fv)
+		return fv, nil
+
+	case reflect.String:
+		sv := fieldVal.String()
+		debugLogger.Infof("Setting %q to %q", path, sv)
+		return sv, nil
+
+	case reflect.Bool:
+		bv := fieldVal.Bool()
+		debugLogger.Infof("Setting %q to %t", path, bv)
+		return bv, nil
+
+	case reflect.Map:
+		iter := fieldVal.MapRange()
+		attr := make(map[string]interface{})
+		for iter.Next() {
+			attr[iter.Key().String()] = iter.Value().Interface()
+		}
+		return attr, nil
+
+	case reflect.Slice:
+		sv := fieldVal.Slice(0, fieldVal.Len())
+		switch sv.Type() {
+		case reflect.TypeOf([]string{}):
+			debugLogger.Infof("Setting %q to []string", path)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]string, 0), nil
+
+		case reflect.TypeOf([]int{}):
+			debugLogger.Infof("Setting %q to []int", path)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]int, 0), nil
+
+		case reflect.TypeOf([]float64{}):
+			debugLogger.Infof("Setting %q to []float64", path)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]float64, 0), nil
+
+		case reflect.TypeOf([]bool{}):
+			debugLogger.Infof("Setting %q to []bool", path)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]bool, 0), nil
+		}
+
+		// Lists and Sets of blocks are both encoded as a list of maps
+		attr := make([]interface{}, sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
+			debugLogger.Infof("[SLICE] Type %+v", sv.Type())
+			nestedPath := fmt.Sprintf("%s.%d", path, i)
+			if sv.Type().Elem().Kind() != reflect.Struct || sv.Type().Elem() == reflect.TypeOf(time.Time{}) {
+				value, err := encodeValue(sv.Type().Elem(), sv.Index(i), nestedPath, debugLogger)
+				if err != nil {
+					return nil, err
+				}
+				attr[i] = value
+				continue
+			}
+
+			serialized, err := recurse(sv.Index(i).Type(), sv.Index(i), nestedPath, debugLogger)
+			if err != nil {
+				return nil, err
+			}
+			attr[i] = serialized
+		}
+		debugLogger.Infof("[SLICE] Setting %q to %+v", path, attr)
+		return attr, nil
+	}
+
+	return nil, fmt.Errorf("encoding %q: unknown type %+v", path, fieldType.Kind())
+}
@@ -1,8 +1,11 @@
 package sdk
 
 import (
+	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -647,13 +650,154 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_TopLevelPointers(t *testing.T) {
+	type SimpleType struct {
+		String    *string  `tfschema:"string"`
+		Number    *int64   `tfschema:"number"`
+		Price     *float64 `tfschema:"price"`
+		Enabled   *bool    `tfschema:"enabled"`
+		Unset     *string  `tfschema:"unset"`
+		ZeroValue *int64   `tfschema:"zero_value"`
+	}
+	str := "world"
+	number := int64(42)
+	price := 129.99
+	enabled := false
+	zero := int64(0)
+	encodeTestData{
+		Input: &SimpleType{
+			String:    &str,
+			Number:    &number,
+			Price:     &price,
+			Enabled:   &enabled,
+			ZeroValue: &zero,
+		},
+		Expected: map[string]interface{}{
+			"string":     "world",
+			"number":     int64(42),
+			"price":      129.99,
+			"enabled":    false,
+			"unset":      nil,
+			"zero_value": int64(0),
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_TopLevelTimeAndJSON(t *testing.T) {
+	type SimpleType struct {
+		Created      time.Time       `tfschema:"created"`
+		Unset        time.Time       `tfschema:"unset"`
+		Settings     json.RawMessage `tfschema:"settings"`
+		UnsetJSON    json.RawMessage `tfschema:"unset_json"`
+		OptionalTime *time.Time      `tfschema:"optional_time"`
+	}
+	encodeTestData{
+		Input: &SimpleType{
+			Created:  time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+			Settings: json.RawMessage(`{"hello":"world"}`),
+		},
+		Expected: map[string]interface{}{
+			"created":       "2022-01-02T03:04:05Z",
+			"unset":         "",
+			"settings":      `{"hello":"world"}`,
+			"unset_json":    "",
+			"optional_time": nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_TopLevelInvalidJSON(t *testing.T) {
+	type SimpleType struct {
+		Settings json.RawMessage `tfschema:"settings"`
+	}
+	encodeTestData{
+		Input: &SimpleType{
+			Settings: json.RawMessage(`{"hello":`),
+		},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceEncode_NestedSetOfBlocks(t *testing.T) {
+	type Inner struct {
+		Name     string          `tfschema:"name"`
+		Priority *int64          `tfschema:"priority"`
+		Expires  time.Time       `tfschema:"expires"`
+		Tags     []string        `tfschema:"tags"`
+		Policy   json.RawMessage `tfschema:"policy"`
+	}
+	type Type struct {
+		Rules []Inner `tfschema:"rule"`
+	}
+	priority := int64(10)
+	encodeTestData{
+		Input: &Type{
+			Rules: []Inner{
+				{
+					Name:     "first",
+					Priority: &priority,
+					Expires:  time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+					Tags:     []string{"hello"},
+					Policy:   json.RawMessage(`[]`),
+				},
+				{
+					Name: "second",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"rule": []interface{}{
+				map[string]interface{}{
+					"name":     "first",
+					"priority": int64(10),
+					"expires":  "2022-01-02T03:04:05Z",
+					"tags":     []string{"hello"},
+					"policy":   "[]",
+				},
+				map[string]interface{}{
+					"name":     "second",
+					"priority": nil,
+					"expires":  "",
+					"tags":     []string{},
+					"policy":   "",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedErrorIncludesPath(t *testing.T) {
+	type Inner struct {
+		Policy json.RawMessage `tfschema:"policy"`
+	}
+	type Type struct {
+		Rules []Inner `tfschema:"rule"`
+	}
+	input := &Type{
+		Rules: []Inner{
+			{
+				Policy: json.RawMessage(`{}`),
+			},
+			{
+				Policy: json.RawMessage(`{`),
+			},
+		},
+	}
+	_, err := recurse(reflect.TypeOf(input).Elem(), reflect.ValueOf(input).Elem(), "", ConsoleLogger{})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one!")
+	}
+	if !strings.Contains(err.Error(), `"rule.1.policy"`) {
+		t.Fatalf("expected the error to contain the path `rule.1.policy` but got: %+v", err)
+	}
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
-	fieldName := reflect.ValueOf(testData.Input).Elem().String()
 	debugLogger := ConsoleLogger{}
 
-	output, err := recurse(objType, objVal, fieldName, debugLogger)
+	output, err := recurse(objType, objVal, "", debugLogger)
 	if err != nil {
 		if testData.ExpectError {
 			// we're good
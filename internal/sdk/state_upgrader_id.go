@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// IDParserFunc parses a Resource ID in its existing format (for example, case-insensitively)
+// returning a Formatter which outputs the Resource ID in the new format
+type IDParserFunc func(input string) (resourceid.Formatter, error)
+
+var _ pluginsdk.StateUpgrade = IDStateUpgrader{}
+
+// IDStateUpgrader is a generic StateUpgrade which rewrites the `id` field (and any other fields containing
+// Resource IDs) into a new format - for example to fix the casing of a segment within the Resource ID.
+//
+// This can be registered for a Resource through ResourceWithStateMigration.StateUpgraders():
+//
+//	func (r ExampleResource) StateUpgraders() sdk.StateUpgradeData {
+//		return sdk.StateUpgradeData{
+//			SchemaVersion: 1,
+//			Upgraders: map[int]pluginsdk.StateUpgrade{
+//				0: sdk.IDStateUpgrader{
+//					Parser: func(input string) (resourceid.Formatter, error) {
+//						return parse.ExampleIDInsensitively(input)
+//					},
+//					PointInTimeSchema: exampleSchemaV0(),
+//				},
+//			},
+//		}
+//	}
+type IDStateUpgrader struct {
+	// Parser parses the existing value of the `id` field
+	Parser IDParserFunc
+
+	// Fields is a map of the paths of any other fields containing Resource IDs to the parser used for them.
+	// Fields within a nested block are specified as `block_name.field_name`, which is rewritten for
+	// each item within the block. Fields containing a list or set of Resource IDs are rewritten for each item.
+	Fields map[string]IDParserFunc
+
+	// PointInTimeSchema is the Schema for the Resource at the time of this version
+	//
+	// NOTE: This shouldn't reference the existing schema since it's a point-in-time reference
+	PointInTimeSchema map[string]*pluginsdk.Schema
+}
+
+// Schema returns the point-in-time Schema for this version of the Resource
+func (u IDStateUpgrader) Schema() map[string]*pluginsdk.Schema {
+	return u.PointInTimeSchema
+}
+
+// UpgradeFunc returns a function which rewrites the Resource IDs within the State
+func (u IDStateUpgrader) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if u.Parser != nil {
+			if err := rewriteResourceIDs(rawState, []string{"id"}, u.Parser); err != nil {
+				return rawState, err
+			}
+		}
+
+		for path, parser := range u.Fields {
+			if err := rewriteResourceIDs(rawState, strings.Split(path, "."), parser); err != nil {
+				return rawState, err
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+// rewriteResourceIDs rewrites the Resource ID(s) found at the specified path within the State, nested blocks
+// are traversed for each item within them - values which are unset or empty are left as-is
+func rewriteResourceIDs(rawState map[string]interface{}, path []string, parser IDParserFunc) error {
+	key := path[0]
+	value, ok := rawState[key]
+	if !ok || value == nil {
+		return nil
+	}
+
+	if len(path) > 1 {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			return rewriteResourceIDs(v, path[1:], parser)
+		case []interface{}:
+			for _, item := range v {
+				block, ok := item.(map[string]interface{})
+				if !ok || block == nil {
+					continue
+				}
+				if err := rewriteResourceIDs(block, path[1:], parser); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		updated, err := rewriteResourceID(key, v, parser)
+		if err != nil {
+			return err
+		}
+		rawState[key] = updated
+
+	case []interface{}:
+		for i, item := range v {
+			raw, ok := item.(string)
+			if !ok {
+				continue
+			}
+			updated, err := rewriteResourceID(key, raw, parser)
+			if err != nil {
+				return err
+			}
+			v[i] = updated
+		}
+	}
+
+	return nil
+}
+
+func rewriteResourceID(field, oldId string, parser IDParserFunc) (string, error) {
+	if oldId == "" {
+		return oldId, nil
+	}
+
+	parsed, err := parser(oldId)
+	if err != nil {
+		return oldId, fmt.Errorf("parsing existing Resource ID %q for %q: %+v", oldId, field, err)
+	}
+
+	newId := parsed.ID()
+	if newId != oldId {
+		log.Printf("[DEBUG] Updating %q from %q to %q", field, oldId, newId)
+	}
+	return newId, nil
+}
@@ -0,0 +1,163 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+type upgraderTestID struct {
+	value string
+}
+
+func (id upgraderTestID) ID() string {
+	return id.value
+}
+
+func upgraderTestParser(input string) (resourceid.Formatter, error) {
+	if !strings.HasPrefix(strings.ToLower(input), "/subscriptions/") {
+		return nil, fmt.Errorf("expected the ID to start with `/subscriptions/`")
+	}
+	return upgraderTestID{
+		value: strings.Replace(input, "/resourcegroups/", "/resourceGroups/", 1),
+	}, nil
+}
+
+func TestIDStateUpgrader(t *testing.T) {
+	testData := []struct {
+		name     string
+		upgrader IDStateUpgrader
+		input    map[string]interface{}
+		expected map[string]interface{}
+		error    bool
+	}{
+		{
+			name: "id",
+			upgrader: IDStateUpgrader{
+				Parser: upgraderTestParser,
+			},
+			input: map[string]interface{}{
+				"id":   "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group1",
+				"name": "group1",
+			},
+			expected: map[string]interface{}{
+				"id":   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				"name": "group1",
+			},
+		},
+		{
+			name: "empty values",
+			upgrader: IDStateUpgrader{
+				Parser: upgraderTestParser,
+				Fields: map[string]IDParserFunc{
+					"other_id":           upgraderTestParser,
+					"block.resource_id":  upgraderTestParser,
+					"missing_id":         upgraderTestParser,
+					"missing.nested_ids": upgraderTestParser,
+				},
+			},
+			input: map[string]interface{}{
+				"id":       "",
+				"other_id": nil,
+				"block":    []interface{}{},
+			},
+			expected: map[string]interface{}{
+				"id":       "",
+				"other_id": nil,
+				"block":    []interface{}{},
+			},
+		},
+		{
+			name: "fields",
+			upgrader: IDStateUpgrader{
+				Parser: upgraderTestParser,
+				Fields: map[string]IDParserFunc{
+					"resource_group_id": upgraderTestParser,
+					"resource_ids":      upgraderTestParser,
+					"block.resource_id": upgraderTestParser,
+				},
+			},
+			input: map[string]interface{}{
+				"id":                "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group1",
+				"resource_group_id": "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group2",
+				"resource_ids": []interface{}{
+					"/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group3",
+					"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group4",
+				},
+				"block": []interface{}{
+					map[string]interface{}{
+						"resource_id": "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group5",
+					},
+					map[string]interface{}{
+						"resource_id": "",
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"id":                "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				"resource_group_id": "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2",
+				"resource_ids": []interface{}{
+					"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group3",
+					"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group4",
+				},
+				"block": []interface{}{
+					map[string]interface{}{
+						"resource_id": "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group5",
+					},
+					map[string]interface{}{
+						"resource_id": "",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid id",
+			upgrader: IDStateUpgrader{
+				Parser: upgraderTestParser,
+			},
+			input: map[string]interface{}{
+				"id": "group1",
+			},
+			error: true,
+		},
+		{
+			name: "invalid nested field",
+			upgrader: IDStateUpgrader{
+				Fields: map[string]IDParserFunc{
+					"block.resource_id": upgraderTestParser,
+				},
+			},
+			input: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"resource_id": "group1",
+					},
+				},
+			},
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := v.upgrader.UpgradeFunc()(context.TODO(), v.input, nil)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -6,20 +6,19 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 // DataSourceWrapper is a wrapper for converting a DataSource implementation
 // into the object used by the Terraform Plugin SDK
 type DataSourceWrapper struct {
 	dataSource DataSource
-	logger     Logger
 }
 
 // NewDataSourceWrapper returns a DataSourceWrapper for this Data Source implementation
 func NewDataSourceWrapper(dataSource DataSource) DataSourceWrapper {
 	return DataSourceWrapper{
 		dataSource: dataSource,
-		logger:     &DiagnosticsLogger{},
 	}
 }
 
@@ -43,9 +42,9 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 
 	resource := schema.Resource{
 		Schema: *resourceSchema,
-		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, dw.logger)
-			return dw.dataSource.Read().Func(ctx, metaData)
+		ReadContext: dw.diagnosticsWrapper("read", func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
+			return dw.dataSource.Read().Func(common.WithCachedReadsOutsideApply(ctx, d), metaData)
 		}),
 		Timeouts: &schema.ResourceTimeout{
 			Read: d(dw.dataSource.Read().Timeout),
@@ -55,6 +54,6 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	return &resource, nil
 }
 
-func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
-	return diagnosticsWrapper(in, dw.logger)
+func (dw *DataSourceWrapper) diagnosticsWrapper(operation string, in operationFunc) schema.ReadContextFunc {
+	return diagnosticsWrapper(dw.dataSource.ResourceType(), operation, in)
 }
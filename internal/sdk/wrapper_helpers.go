@@ -55,3 +55,37 @@ func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) ResourceMe
 
 	return metaData
 }
+
+// loggerFromMeta returns a Logger using the format configured in the Provider block
+func loggerFromMeta(meta interface{}) Logger {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return NewLogger(client.LogFormat)
+	}
+
+	return ConsoleLogger{}
+}
+
+// operationLogger returns a Logger containing the context for an operation being performed on a resource
+func operationLogger(logger Logger, resourceType, operation, resourceId string, meta interface{}) Logger {
+	fields := LogFields{
+		LogFieldOperation:    operation,
+		LogFieldResourceType: resourceType,
+	}
+	if resourceId != "" {
+		fields[LogFieldResourceID] = resourceId
+	}
+	if correlationRequestId := correlationRequestIdFromMeta(meta); correlationRequestId != "" {
+		fields[LogFieldCorrelationRequestID] = correlationRequestId
+	}
+
+	return logger.WithFields(fields)
+}
+
+// correlationRequestIdFromMeta returns the `x-ms-correlation-request-id` sent to Azure, if enabled
+func correlationRequestIdFromMeta(meta interface{}) string {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.CorrelationRequestID
+	}
+
+	return ""
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -47,12 +49,15 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		CreateContext: rw.diagnosticsWrapper("create", func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
+			metaData.Logger = logger.WithFields(LogFields{
+				LogFieldResourceID: d.Id(),
+			})
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
@@ -60,12 +65,12 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Read().Func(ctx, metaData)
+		ReadContext: rw.diagnosticsWrapper("read", func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
+			return rw.resource.Read().Func(common.WithCachedReadsOutsideApply(ctx, d), metaData)
 		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		DeleteContext: rw.diagnosticsWrapper("delete", func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -93,7 +98,8 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				logger := operationLogger(loggerFromMeta(meta), rw.resource.ResourceType(), "import", d.Id(), meta)
+				metaData := runArgs(d, meta, logger)
 
 				err := v.CustomImporter()(ctx, metaData)
 				if err != nil {
@@ -110,8 +116,8 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		resource.UpdateContext = rw.diagnosticsWrapper("update", func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -130,7 +136,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			client := meta.(*clients.Client)
 			metaData := ResourceMetaData{
 				Client:                   client,
-				Logger:                   rw.logger,
+				Logger:                   operationLogger(loggerFromMeta(meta), rw.resource.ResourceType(), "plan", d.Id(), meta),
 				ResourceDiff:             d,
 				serializationDebugLogger: NullLogger{},
 			}
@@ -175,25 +181,28 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
-func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+func (rw *ResourceWrapper) diagnosticsWrapper(operation string, in operationFunc) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsWrapper(rw.resource.ResourceType(), operation, in)
 }
 
-func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// operationFunc is a function performing an operation on a resource, which is provided a Logger
+// containing the context for this operation
+type operationFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error
+
+func diagnosticsWrapper(resourceType, operation string, in operationFunc) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// a DiagnosticsLogger is used per-operation, so that only the warnings for this operation are returned
+		diagsLogger := NewDiagnosticsLogger(loggerFromMeta(meta))
+		logger := operationLogger(diagsLogger, resourceType, operation, d.Id(), meta)
+
 		out := make([]diag.Diagnostic, 0)
-		if err := in(ctx, d, meta); err != nil {
-			out = append(out, diag.Diagnostic{
-				Severity:      diag.Error,
-				Summary:       err.Error(),
-				Detail:        err.Error(),
-				AttributePath: nil,
-			})
+		if err := in(ctx, d, meta, logger); err != nil {
+			logger.Error(err.Error())
+			// errors returned from Azure are decoded so that the ARM error (and any policy violations) are readable
+			out = append(out, azure.ErrorDiagnostics(err, correlationRequestIdFromMeta(meta))...)
 		}
 
-		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
-			out = append(out, diagsLogger.diagnostics...)
-		}
+		out = append(out, diagsLogger.diagnostics...)
 
 		return out
 	}
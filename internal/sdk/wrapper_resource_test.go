@@ -0,0 +1,34 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestDiagnosticsWrapperDecodesArmErrors(t *testing.T) {
+	wrapped := diagnosticsWrapper("azurerm_example", "create", func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+		return fmt.Errorf(`creating Example: example.Client#CreateOrUpdate: Failure sending request: StatusCode=409 -- Original Error: Code="Conflict" Message="The resource already exists."`)
+	})
+
+	d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).TestResourceData()
+	meta := &clients.Client{
+		CorrelationRequestID: "abc123",
+	}
+
+	actual := wrapped(context.TODO(), d, meta)
+	if len(actual) != 1 || actual[0].Severity != diag.Error {
+		t.Fatalf("expected a single error but got %+v", actual)
+	}
+	if expected := "creating Example: Conflict: The resource already exists."; actual[0].Summary != expected {
+		t.Fatalf("expected the summary to be %q but got %q", expected, actual[0].Summary)
+	}
+	if !strings.Contains(actual[0].Detail, "Correlation Request ID: abc123") {
+		t.Fatalf("expected the detail to contain the Correlation Request ID but got %q", actual[0].Detail)
+	}
+}
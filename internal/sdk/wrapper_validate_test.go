@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -12,6 +16,17 @@ func TestValidateTopLevelObjectValid(t *testing.T) {
 	}
 }
 
+func TestValidateTopLevelObjectValidTypes(t *testing.T) {
+	type Person struct {
+		Nickname *string         `tfschema:"nickname"`
+		Born     time.Time       `tfschema:"born"`
+		Settings json.RawMessage `tfschema:"settings"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
 func TestValidateTopLevelObjectInvalid(t *testing.T) {
 	t.Log("Person1")
 	type Person1 struct {
@@ -193,7 +193,7 @@ func (r AadB2cDirectoryResource) Create() sdk.ResourceFunc {
 					Name: tenants.SkuName(model.Sku),
 					Tier: tenants.SkuTierA0,
 				},
-				Tags: &model.Tags,
+				Tags: tags.PointerWithDefaults(&model.Tags),
 			}
 
 			if err := client.CreateThenPoll(ctx, id, properties); err != nil {
@@ -230,7 +230,7 @@ func (r AadB2cDirectoryResource) Update() sdk.ResourceFunc {
 					Name: tenants.SkuName(state.Sku),
 					Tier: tenants.SkuTierA0,
 				},
-				Tags: &state.Tags,
+				Tags: tags.PointerWithDefaults(&state.Tags),
 			}
 
 			if _, err := client.Update(ctx, *id, properties); err != nil {
@@ -17,6 +17,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -166,7 +167,7 @@ func resourceAnalysisServicesServerCreate(d *pluginsdk.ResourceData, meta interf
 			Name: d.Get("sku").(string),
 		},
 		Properties: serverProperties,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if err := client.CreateThenPoll(ctx, id, analysisServicesServer); err != nil {
@@ -282,7 +283,7 @@ func resourceAnalysisServicesServerUpdate(d *pluginsdk.ResourceData, meta interf
 		Sku: &servers.ResourceSku{
 			Name: sku,
 		},
-		Tags:       tags.Expand(t),
+		Tags:       providerTags.PointerWithDefaults(tags.Expand(t)),
 		Properties: serverProperties,
 	}
 
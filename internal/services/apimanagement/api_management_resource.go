@@ -720,7 +720,7 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 			CustomProperties:    customProperties,
 			Certificates:        certificates,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 		Sku:  sku,
 	}
 
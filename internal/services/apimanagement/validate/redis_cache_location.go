@@ -3,7 +3,7 @@ package validate
 import (
 	"fmt"
 
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
 
 func RedisCacheLocation(input interface{}, key string) (warnings []string, errors []error) {
@@ -17,5 +17,5 @@ func RedisCacheLocation(input interface{}, key string) (warnings []string, error
 		return warnings, errors
 	}
 
-	return location.EnhancedValidate(v, key)
+	return resourceproviders.EnhancedValidateLocation(v, key)
 }
@@ -239,7 +239,7 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Location:                               &location,
 		Kind:                                   &applicationType,
 		ApplicationInsightsComponentProperties: &applicationInsightsComponentProperties,
-		Tags:                                   tags.Expand(t),
+		Tags:                                   tags.ExpandWithDefaults(t),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, insightProperties)
@@ -194,7 +194,7 @@ func resourceApplicationInsightsWebTestsCreateUpdate(d *pluginsdk.ResourceData,
 				WebTest: &testConf,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	_, err = client.CreateOrUpdate(ctx, id, webTest)
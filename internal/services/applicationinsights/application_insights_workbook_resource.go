@@ -12,6 +12,7 @@ import (
 	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -160,7 +161,7 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 					SourceId:       &model.SourceId,
 				},
 
-				Tags: &model.Tags,
+				Tags: tags.PointerWithDefaults(&model.Tags),
 			}
 
 			if model.Description != "" {
@@ -223,8 +224,8 @@ func (r ApplicationInsightsWorkbookResource) Update() sdk.ResourceFunc {
 				properties.Properties.SerializedData = model.DataJson
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				properties.Tags = &model.Tags
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				properties.Tags = tags.PointerWithDefaults(&model.Tags)
 			}
 
 			if _, err := client.WorkbooksCreateOrUpdate(ctx, *id, *properties, workbooks.WorkbooksCreateOrUpdateOperationOptions{SourceId: &model.SourceId}); err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	workbooktemplates "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -173,7 +174,7 @@ func (r ApplicationInsightsWorkbookTemplateResource) Create() sdk.ResourceFunc {
 					TemplateData: templateDataValue,
 				},
 
-				Tags: &model.Tags,
+				Tags: tags.PointerWithDefaults(&model.Tags),
 			}
 
 			if model.Author != "" {
@@ -272,8 +273,8 @@ func (r ApplicationInsightsWorkbookTemplateResource) Update() sdk.ResourceFunc {
 				properties.Properties.Localized = &localizedValue
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				properties.Tags = &model.Tags
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				properties.Tags = tags.PointerWithDefaults(&model.Tags)
 			}
 
 			if _, err := client.WorkbookTemplatesCreateOrUpdate(ctx, *id, *properties); err != nil {
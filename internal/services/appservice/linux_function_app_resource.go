@@ -440,7 +440,7 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: utils.String(functionApp.Location),
-				Tags:     tags.FromTypedObject(functionApp.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(functionApp.Tags)),
 				Kind:     utils.String("functionapp,linux"),
 				Identity: expandedIdentity,
 				SiteProperties: &web.SiteProperties{
@@ -761,8 +761,8 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			storageString := state.StorageAccountName
@@ -435,7 +435,7 @@ func (r LinuxFunctionAppSlotResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: functionApp.Location,
-				Tags:     tags.FromTypedObject(functionAppSlot.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(functionAppSlot.Tags)),
 				Kind:     utils.String("functionapp,linux"),
 				Identity: expandedIdentity,
 				SiteProperties: &web.SiteProperties{
@@ -708,8 +708,8 @@ func (r LinuxFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
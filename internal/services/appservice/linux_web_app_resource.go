@@ -307,7 +307,7 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 			siteEnvelope := web.Site{
 				Location: utils.String(webApp.Location),
 				Identity: expandedIdentity,
-				Tags:     tags.FromTypedObject(webApp.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(webApp.Tags)),
 				SiteProperties: &web.SiteProperties{
 					ServerFarmID:          utils.String(webApp.ServicePlanId),
 					Enabled:               utils.Bool(webApp.Enabled),
@@ -657,8 +657,8 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("site_config") || servicePlanChange {
@@ -274,7 +274,7 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 			siteEnvelope := web.Site{
 				Location: webApp.Location,
 				Identity: expandedIdentity,
-				Tags:     tags.FromTypedObject(webAppSlot.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(webAppSlot.Tags)),
 				SiteProperties: &web.SiteProperties{
 					ServerFarmID:          siteProps.ServerFarmID,
 					Enabled:               utils.Bool(webAppSlot.Enabled),
@@ -580,8 +580,8 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("site_config") {
@@ -172,7 +172,7 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 					Name: utils.String(servicePlan.Sku),
 				},
 				Location: utils.String(location.Normalize(servicePlan.Location)),
-				Tags:     tags.FromTypedObject(servicePlan.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(servicePlan.Tags)),
 			}
 
 			if servicePlan.AppServiceEnvironmentId != "" {
@@ -329,8 +329,8 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				existing.Sku.Name = utils.String(state.Sku)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("worker_count") {
@@ -438,7 +438,7 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: utils.String(functionApp.Location),
-				Tags:     tags.FromTypedObject(functionApp.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(functionApp.Tags)),
 				Kind:     utils.String("functionapp"),
 				Identity: expandedIdentity,
 				SiteProperties: &web.SiteProperties{
@@ -744,8 +744,8 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
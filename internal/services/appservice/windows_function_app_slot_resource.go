@@ -441,7 +441,7 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: functionApp.Location,
-				Tags:     tags.FromTypedObject(functionAppSlot.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(functionAppSlot.Tags)),
 				Kind:     utils.String("functionapp"),
 				Identity: expandedIdentity,
 				SiteProperties: &web.SiteProperties{
@@ -715,8 +715,8 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
@@ -304,7 +304,7 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: utils.String(webApp.Location),
-				Tags:     tags.FromTypedObject(webApp.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(webApp.Tags)),
 				Identity: expandedIdentity,
 				SiteProperties: &web.SiteProperties{
 					ServerFarmID:          utils.String(webApp.ServicePlanId),
@@ -667,8 +667,8 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
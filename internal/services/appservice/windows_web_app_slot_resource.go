@@ -263,7 +263,7 @@ func (r WindowsWebAppSlotResource) Create() sdk.ResourceFunc {
 
 			siteEnvelope := web.Site{
 				Location: webApp.Location,
-				Tags:     tags.FromTypedObject(webAppSlot.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(webAppSlot.Tags)),
 				Identity: expandedIdentity,
 				SiteProperties: &web.SiteProperties{
 					ServerFarmID:          siteProps.ServerFarmID,
@@ -582,8 +582,8 @@ func (r WindowsWebAppSlotResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			currentStack := ""
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -97,7 +98,7 @@ func resourceAttestationProviderCreate(d *pluginsdk.ResourceData, meta interface
 		Properties: attestationproviders.AttestationServiceCreationSpecificParams{
 			// AttestationPolicy was deprecated in October of 2019
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	// NOTE: This maybe an slice in a future release or even a slice of slices
@@ -170,8 +171,8 @@ func resourceAttestationProviderUpdate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	updateParams := attestationproviders.AttestationServicePatchParams{}
-	if d.HasChange("tags") {
-		updateParams.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		updateParams.Tags = providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{})))
 	}
 
 	if _, err := client.Update(ctx, *id, updateParams); err != nil {
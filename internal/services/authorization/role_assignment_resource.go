@@ -88,6 +88,14 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"principal_type": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -136,10 +144,14 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 }
 
 func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
-	subscriptionClient := meta.(*clients.Client).Subscription.Client
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	metaClient, err := meta.(*clients.Client).ForSubscription(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	roleAssignmentsClient := metaClient.Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := metaClient.Authorization.RoleDefinitionsClient
+	subscriptionClient := metaClient.Subscription.Client
+	subscriptionId := metaClient.Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -223,7 +235,7 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		properties.RoleAssignmentProperties.PrincipalType = authorization.ServicePrincipal
 	}
 
-	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId)); err != nil {
+	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, metaClient, tenantId)); err != nil {
 		return err
 	}
 
@@ -236,12 +248,11 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(parse.ConstructRoleAssignmentId(*read.ID, tenantId))
+	d.Set("subscription_id", subscriptionId)
 	return resourceArmRoleAssignmentRead(d, meta)
 }
 
 func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -249,6 +260,20 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+
+	// Role Assignments can be scoped to a Management Group, as such the Subscription used to manage this
+	// can't always be determined from the ID - and so is only taken from the ID when importing
+	subscriptionId := d.Get("subscription_id").(string)
+	if subscriptionId == "" {
+		subscriptionId = id.SubscriptionID
+	}
+
+	metaClient, err := meta.(*clients.Client).ForSubscription(subscriptionId)
+	if err != nil {
+		return err
+	}
+	client := metaClient.Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := metaClient.Authorization.RoleDefinitionsClient
 	resp, err := client.GetByID(ctx, id.AzureResourceID(), id.TenantId)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -261,6 +286,7 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.Set("name", resp.Name)
+	d.Set("subscription_id", metaClient.Account.SubscriptionId)
 
 	if props := resp.RoleAssignmentPropertiesWithScope; props != nil {
 		d.Set("scope", props.Scope)
@@ -289,7 +315,11 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 }
 
 func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	metaClient, err := meta.(*clients.Client).ForSubscription(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	client := metaClient.Authorization.RoleAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
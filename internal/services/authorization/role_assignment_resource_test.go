@@ -228,6 +228,28 @@ func TestAccRoleAssignment_resourceScoped(t *testing.T) {
 	})
 }
 
+func TestAccRoleAssignment_crossSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
+	if data.Subscriptions.Secondary == "" {
+		t.Skipf("The secondary subscription is not specified")
+	}
+	id := uuid.New().String()
+
+	r := RoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossSubscriptionConfig(id, data.Subscriptions.Secondary),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Subscriptions.Secondary),
+				check.That(data.ResourceName).Key("role_definition_name").HasValue("Log Analytics Reader"),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check"),
+	})
+}
+
 func (r RoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RoleAssignmentID(state.ID)
 	if err != nil {
@@ -283,6 +305,25 @@ resource "azurerm_role_assignment" "test" {
 `, id)
 }
 
+func (RoleAssignmentResource) crossSubscriptionConfig(id string, subscriptionId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                 = "%[1]s"
+  subscription_id      = "%[2]s"
+  scope                = "/subscriptions/%[2]s"
+  role_definition_name = "Log Analytics Reader"
+  principal_id         = data.azurerm_client_config.test.object_id
+}
+`, id, subscriptionId)
+}
+
 func (RoleAssignmentResource) roleResourceScoped(data acceptance.TestData, id string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
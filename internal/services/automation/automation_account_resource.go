@@ -187,7 +187,7 @@ func resourceAutomationAccountCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.Identity = identityVal
 	}
 	if tagsVal := expandTags(d.Get("tags").(map[string]interface{})); tagsVal != nil {
-		parameters.Tags = &tagsVal
+		parameters.Tags = tags.PointerWithDefaults(&tagsVal)
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
@@ -235,7 +235,7 @@ func resourceAutomationAccountUpdate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	if tagsVal := expandTags(d.Get("tags").(map[string]interface{})); tagsVal != nil {
-		parameters.Tags = &tagsVal
+		parameters.Tags = tags.PointerWithDefaults(&tagsVal)
 	}
 
 	if _, err := client.Update(ctx, *id, parameters); err != nil {
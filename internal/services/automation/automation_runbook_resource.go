@@ -182,7 +182,7 @@ func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta inter
 		},
 
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	contentLink := expandContentLink(d.Get("publish_content_link").([]interface{}))
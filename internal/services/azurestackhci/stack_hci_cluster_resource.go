@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -92,7 +93,7 @@ func resourceArmStackHCIClusterCreate(d *pluginsdk.ResourceData, meta interface{
 		Properties: &clusters.ClusterProperties{
 			AadClientId: d.Get("client_id").(string),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if v, ok := d.GetOk("tenant_id"); ok {
@@ -163,8 +164,8 @@ func resourceArmStackHCIClusterUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	cluster := clusters.ClusterUpdate{}
 
-	if d.HasChange("tags") {
-		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		cluster.Tags = providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{})))
 	}
 
 	if _, err := client.Update(ctx, *id, cluster); err != nil {
@@ -179,7 +179,7 @@ func resourceBatchAccountCreate(d *pluginsdk.ResourceData, meta interface{}) err
 			Encryption:          encryption,
 		},
 		Identity: identity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
@@ -314,7 +314,7 @@ func resourceBatchAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 			Encryption: encryption,
 		},
 		Identity: identity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	if d.HasChange("storage_account_id") {
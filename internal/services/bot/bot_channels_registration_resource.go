@@ -224,7 +224,7 @@ func resourceBotChannelsRegistrationCreate(d *pluginsdk.ResourceData, meta inter
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindBot,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
@@ -344,7 +344,7 @@ func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta inter
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindBot,
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
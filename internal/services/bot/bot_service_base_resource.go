@@ -181,7 +181,7 @@ func (br botBaseResource) createFunc(resourceName, botKind string) sdk.ResourceF
 					LuisKey:                           utils.String(metadata.ResourceData.Get("luis_key").(string)),
 					IsStreamingSupported:              utils.Bool(metadata.ResourceData.Get("streaming_endpoint_enabled").(bool)),
 				},
-				Tags: tags.Expand(metadata.ResourceData.Get("tags").(map[string]interface{})),
+				Tags: tags.ExpandWithDefaults(metadata.ResourceData.Get("tags").(map[string]interface{})),
 			}
 
 			if v, ok := metadata.ResourceData.GetOk("microsoft_app_type"); ok {
@@ -376,6 +376,10 @@ func (br botBaseResource) updateFunc() sdk.ResourceFunc {
 				existing.Properties.IsStreamingSupported = utils.Bool(metadata.ResourceData.Get("streaming_endpoint_enabled").(bool))
 			}
 
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.ExpandWithDefaults(metadata.ResourceData.Get("tags").(map[string]interface{}))
+			}
+
 			if _, err := client.Update(ctx, id.ResourceGroup, id.Name, existing); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
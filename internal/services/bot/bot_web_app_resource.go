@@ -184,7 +184,7 @@ func resourceBotWebAppCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindSdk,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.Name, bot); err != nil {
@@ -269,7 +269,7 @@ func resourceBotWebAppUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindSdk,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, bot); err != nil {
@@ -242,7 +242,7 @@ func resourceCdnEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 			IsHTTPSAllowed:             &httpsAllowed,
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("origin_host_header"); ok {
@@ -338,7 +338,7 @@ func resourceCdnEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			IsHTTPSAllowed:             utils.Bool(httpsAllowed),
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("origin_host_header"); ok {
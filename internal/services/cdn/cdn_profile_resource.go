@@ -97,7 +97,7 @@ func resourceCdnProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Sku: &cdn.Sku{
 			Name: cdn.SkuName(sku),
 		},
@@ -122,7 +122,7 @@ func resourceCdnProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	if !d.HasChange("tags") {
+	if !d.HasChanges("tags", "tags_all") {
 		return nil
 	}
 
@@ -134,7 +134,7 @@ func resourceCdnProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := cdn.ProfileUpdateParameters{
-		Tags: tags.Expand(newTags),
+		Tags: tags.ExpandWithDefaults(newTags),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, props)
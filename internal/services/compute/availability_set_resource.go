@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/availabilitysets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -125,7 +126,7 @@ func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 			PlatformFaultDomainCount:  utils.Int64(int64(faultDomainCount)),
 			PlatformUpdateDomainCount: utils.Int64(int64(updateDomainCount)),
 		},
-		Tags: tags.Expand(t),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(t)),
 	}
 
 	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
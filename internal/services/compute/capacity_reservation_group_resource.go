@@ -82,7 +82,7 @@ func resourceCapacityReservationGroupCreate(d *pluginsdk.ResourceData, meta inte
 	parameters := compute.CapacityReservationGroup{
 		Name:     utils.String(name),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	zones := zones.Expand(d.Get("zones").(*schema.Set).List())
@@ -137,8 +137,8 @@ func resourceCapacityReservationGroupUpdate(d *pluginsdk.ResourceData, meta inte
 
 	parameters := compute.CapacityReservationGroupUpdate{}
 
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		parameters.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
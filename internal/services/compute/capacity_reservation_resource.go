@@ -112,7 +112,7 @@ func resourceCapacityReservationCreate(d *pluginsdk.ResourceData, meta interface
 	parameters := compute.CapacityReservation{
 		Location: capacityReservationGroup.Location,
 		Sku:      expandCapacityReservationSku(d.Get("sku").([]interface{})),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("zone"); ok {
@@ -186,8 +186,8 @@ func resourceCapacityReservationUpdate(d *pluginsdk.ResourceData, meta interface
 		parameters.Sku = expandCapacityReservationSku(d.Get("sku").([]interface{}))
 	}
 
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		parameters.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, parameters)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -97,7 +98,7 @@ func resourceDedicatedHostGroupCreate(d *pluginsdk.ResourceData, meta interface{
 		Properties: &dedicatedhostgroups.DedicatedHostGroupProperties{
 			PlatformFaultDomainCount: int64(platformFaultDomainCount),
 		},
-		Tags: tags.Expand(t),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(t)),
 	}
 
 	if zone, ok := d.GetOk("zone"); ok {
@@ -175,7 +176,7 @@ func resourceDedicatedHostGroupUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	payload := dedicatedhostgroups.DedicatedHostGroupUpdate{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if _, err := client.Update(ctx, *id, payload); err != nil {
@@ -79,7 +79,7 @@ func resourceDiskAccessCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	createDiskAccess := compute.DiskAccess{
 		Name:     &id.Name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, createDiskAccess)
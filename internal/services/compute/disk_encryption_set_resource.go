@@ -144,7 +144,7 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 			EncryptionType:                    compute.DiskEncryptionSetType(encryptionType),
 		},
 		Identity: expandedIdentity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	if keyVaultDetails != nil {
@@ -227,8 +227,8 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	update := compute.DiskEncryptionSetUpdate{}
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		update.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("key_vault_key_id") {
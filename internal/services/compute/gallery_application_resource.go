@@ -148,7 +148,7 @@ func (r GalleryApplicationResource) Create() sdk.ResourceFunc {
 				GalleryApplicationProperties: &compute.GalleryApplicationProperties{
 					SupportedOSType: compute.OperatingSystemTypes(state.SupportedOSType),
 				},
-				Tags: tags.FromTypedObject(state.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(state.Tags)),
 			}
 
 			if state.Description != "" {
@@ -290,8 +290,8 @@ func (r GalleryApplicationResource) Update() sdk.ResourceFunc {
 				existing.GalleryApplicationProperties.ReleaseNoteURI = utils.String(state.ReleaseNoteURI)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, existing)
@@ -236,7 +236,7 @@ func (r GalleryApplicationVersionResource) Create() sdk.ResourceFunc {
 						TargetRegions:     expandGalleryApplicationVersionTargetRegion(state.TargetRegion),
 					},
 				},
-				Tags: tags.FromTypedObject(state.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(state.Tags)),
 			}
 
 			if state.EndOfLifeDate != "" {
@@ -373,8 +373,8 @@ func (r GalleryApplicationVersionResource) Update() sdk.ResourceFunc {
 				existing.GalleryApplicationVersionProperties.PublishingProfile.TargetRegions = expandGalleryApplicationVersionTargetRegion(state.TargetRegion)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, existing)
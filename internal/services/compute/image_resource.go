@@ -209,7 +209,7 @@ func resourceImageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	expandedTags := tags.Expand(d.Get("tags").(map[string]interface{}))
+	expandedTags := tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 
 	properties := compute.ImageProperties{
 		HyperVGeneration: compute.HyperVGenerationTypes(hyperVGeneration),
@@ -512,7 +512,7 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Sku: &compute.DiskSku{
 			Name: skuName,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if zone, ok := d.GetOk("zone"); ok {
@@ -593,9 +593,9 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		diskUpdate.Tier = &tier
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		diskUpdate.Tags = tags.Expand(t)
+		diskUpdate.Tags = tags.ExpandWithDefaults(t)
 	}
 
 	if d.HasChange("storage_account_type") {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -89,7 +90,7 @@ func resourceSshPublicKeyCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		Properties: &sshpublickeys.SshPublicKeyResourceProperties{
 			PublicKey: utils.String(d.Get("public_key").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if _, err := client.Create(ctx, id, payload); err != nil {
@@ -165,9 +166,9 @@ func resourceSshPublicKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 			PublicKey: utils.String(d.Get("public_key").(string)),
 		}
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		payload.Tags = tags.Expand(tagsRaw)
+		payload.Tags = providerTags.PointerWithDefaults(tags.Expand(tagsRaw))
 	}
 
 	if _, err := client.Update(ctx, *id, payload); err != nil {
@@ -151,7 +151,7 @@ func resourceVirtualMachineExtensionsCreateUpdate(d *pluginsdk.ResourceData, met
 			AutoUpgradeMinorVersion: &autoUpgradeMinor,
 			EnableAutomaticUpgrade:  &enableAutomaticUpgrade,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if settingsString := d.Get("settings").(string); settingsString != "" {
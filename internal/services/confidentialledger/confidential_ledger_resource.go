@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -157,7 +158,7 @@ func resourceConfidentialLedgerCreate(d *pluginsdk.ResourceData, meta interface{
 			CertBasedSecurityPrincipals: certBasedUsers,
 			LedgerType:                  &ledgerType,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if err := client.LedgerCreateThenPoll(ctx, id, parameters); err != nil {
@@ -260,8 +261,8 @@ func resourceConfidentialLedgerUpdate(d *pluginsdk.ResourceData, meta interface{
 		ledger.Properties.CertBasedSecurityPrincipals = certBasedUsers
 	}
 
-	if d.HasChange("tags") {
-		ledger.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		ledger.Tags = providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{})))
 	}
 
 	if err := client.LedgerUpdateThenPoll(ctx, *id, ledger); err != nil {
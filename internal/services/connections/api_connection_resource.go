@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -121,7 +122,7 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 			DisplayName:     utils.String(d.Get("display_name").(string)),
 			ParameterValues: parameterValues,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 	if v := d.Get("display_name").(string); v != "" {
 		model.Properties.DisplayName = utils.String(v)
@@ -199,7 +200,7 @@ func resourceConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 		// > Status=400 Code="PatchApiConnectionPropertiesNotSupported"
 		// > Message="The request to patch API connection 'acctestconn-220307135205093274' is not supported.
 		// > None of the fields inside the properties object can be patched."
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 	if _, err := client.Update(ctx, *id, model); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
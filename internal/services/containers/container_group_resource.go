@@ -24,6 +24,7 @@ import (
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -654,7 +655,7 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	containerGroup := containerinstance.ContainerGroup{
 		Name:     pointer.FromString(id.ContainerGroupName),
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 		Properties: containerinstance.ContainerGroupProperties{
 			InitContainers:           initContainers,
 			Containers:               containers,
@@ -739,7 +740,7 @@ func resourceContainerGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := containerinstance.Resource{
-		Tags: tags.Expand(t),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(t)),
 	}
 
 	if _, err := client.ContainerGroupsUpdate(ctx, *id, parameters); err != nil {
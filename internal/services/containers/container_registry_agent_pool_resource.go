@@ -129,7 +129,7 @@ func resourceContainerRegistryAgentPoolCreate(d *pluginsdk.ResourceData, meta in
 			Tier:  &tier,
 		},
 
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("virtual_network_subnet_id"); ok {
@@ -712,7 +712,7 @@ func (r ContainerRegistryTaskResource) Create() sdk.ResourceFunc {
 				// The location of the task must be the same as the registry, otherwise the API will raise error complaining can't find the registry.
 				Location: utils.String(location.NormalizeNilable(registry.Location)),
 				Identity: expandedIdentity,
-				Tags:     tags.FromTypedObject(model.Tags),
+				Tags:     tags.WithDefaults(tags.FromTypedObject(model.Tags)),
 			}
 
 			if model.AgentPoolName != "" {
@@ -938,8 +938,8 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("timeout_in_seconds") {
 				existing.TaskProperties.Timeout = utils.Int32(int32(model.TimeoutInSec))
 			}
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(model.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(model.Tags))
 			}
 
 			// Due to the fact that the service doesn't honor explicitly set to null fields in the PATCH request,
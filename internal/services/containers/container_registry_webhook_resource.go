@@ -135,7 +135,7 @@ func resourceContainerRegistryWebhookCreate(d *pluginsdk.ResourceData, meta inte
 	webhook := containerregistry.WebhookCreateParameters{
 		Location:                          &location,
 		WebhookPropertiesCreateParameters: expandWebhookPropertiesCreateParameters(d),
-		Tags:                              tags.Expand(t),
+		Tags:                              tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.Name, webhook)
@@ -168,7 +168,7 @@ func resourceContainerRegistryWebhookUpdate(d *pluginsdk.ResourceData, meta inte
 
 	webhook := containerregistry.WebhookUpdateParameters{
 		WebhookPropertiesUpdateParameters: expandWebhookPropertiesUpdateParameters(d),
-		Tags:                              tags.Expand(t),
+		Tags:                              tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.Name, webhook)
@@ -390,7 +390,7 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		KubeletDiskType:        containerservice.KubeletDiskType(d.Get("kubelet_disk_type").(string)),
 		Mode:                   mode,
 		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
-		Tags:                   tags.Expand(t),
+		Tags:                   tags.ExpandWithDefaults(t),
 		Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
 		VMSize:                 utils.String(d.Get("vm_size").(string)),
 		UpgradeSettings:        expandUpgradeSettings(d.Get("upgrade_settings").([]interface{})),
@@ -642,9 +642,9 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		props.Tags = tags.Expand(t)
+		props.Tags = tags.ExpandWithDefaults(t)
 	}
 
 	if d.HasChange("upgrade_settings") {
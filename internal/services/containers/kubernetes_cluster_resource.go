@@ -1132,7 +1132,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			OidcIssuerProfile:      oidcIssuerProfile,
 			SecurityProfile:        microsoftDefender,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v := d.Get("automatic_channel_upgrade").(string); v != "" {
@@ -1504,10 +1504,10 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
-		existing.Tags = tags.Expand(t)
+		existing.Tags = tags.ExpandWithDefaults(t)
 	}
 
 	if d.HasChange("windows_profile") {
@@ -658,7 +658,7 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.Manag
 		Name:                   utils.String(raw["name"].(string)),
 		NodeLabels:             nodeLabels,
 		NodeTaints:             nodeTaints,
-		Tags:                   tags.Expand(t),
+		Tags:                   tags.ExpandWithDefaults(t),
 		Type:                   containerservice.AgentPoolType(raw["type"].(string)),
 		VMSize:                 utils.String(raw["vm_size"].(string)),
 
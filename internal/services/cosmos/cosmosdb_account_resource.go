@@ -664,7 +664,7 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 			DisableLocalAuth:                   utils.Bool(disableLocalAuthentication),
 			DefaultIdentity:                    utils.String(d.Get("default_identity_type").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("analytical_storage"); ok {
@@ -822,7 +822,7 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 			DisableLocalAuth:                   utils.Bool(disableLocalAuthentication),
 			DefaultIdentity:                    utils.String(d.Get("default_identity_type").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("analytical_storage"); ok {
@@ -112,7 +112,7 @@ func resourceDatabaseMigrationProjectCreateUpdate(d *pluginsdk.ResourceData, met
 			SourcePlatform: datamigration.ProjectSourcePlatform(sourcePlatform),
 			TargetPlatform: datamigration.ProjectTargetPlatform(targetPlatform),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, parameters, id.ResourceGroup, id.ServiceName, id.Name); err != nil {
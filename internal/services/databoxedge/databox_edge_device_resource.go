@@ -148,7 +148,7 @@ func resourceDeviceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	dataBoxEdgeDevice := databoxedge.Device{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Sku:      expandDeviceSku(d.Get("sku_name").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 	future, err := client.CreateOrUpdate(ctx, id.DataBoxEdgeDeviceName, dataBoxEdgeDevice, id.ResourceGroup)
 	if err != nil {
@@ -211,8 +211,8 @@ func resourceDeviceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	parameters := databoxedge.DevicePatch{}
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		parameters.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.DataBoxEdgeDeviceName, parameters, id.ResourceGroup); err != nil {
@@ -200,7 +200,7 @@ func resourceDatadogMonitorCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			UserInfo:                      expandMonitorUserInfo(d.Get("user").([]interface{})),
 			MonitoringStatus:              monitoringStatus,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 	future, err := client.Create(ctx, resourceGroup, name, &body)
 	if err != nil {
@@ -278,8 +278,8 @@ func resourceDatadogMonitorUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		body.Properties.MonitoringStatus = monitoringStatus
 	}
-	if d.HasChange("tags") {
-		body.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		body.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.MonitorName, &body)
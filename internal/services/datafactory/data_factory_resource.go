@@ -252,7 +252,7 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 			PublicNetworkAccess: publicNetworkAccess,
 		},
 		Identity: expandedIdentity,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if purviewId, ok := d.GetOk("purview_id"); ok {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -272,7 +273,7 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 	payload := scalingplan.ScalingPlan{
 		Name:     utils.String(d.Get("name").(string)),
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     providerTags.PointerWithDefaults(tags.Expand(t)),
 		Properties: &scalingplan.ScalingPlanProperties{
 			Description:        utils.String(d.Get("description").(string)),
 			FriendlyName:       utils.String(d.Get("friendly_name").(string)),
@@ -308,7 +309,7 @@ func resourceVirtualDesktopScalingPlanUpdate(d *pluginsdk.ResourceData, meta int
 	t := d.Get("tags").(map[string]interface{})
 
 	payload := scalingplan.ScalingPlanPatch{
-		Tags: tags.Expand(t),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(t)),
 		Properties: &scalingplan.ScalingPlanPatchProperties{
 			Description:        utils.String(d.Get("description").(string)),
 			FriendlyName:       utils.String(d.Get("friendly_name").(string)),
@@ -131,7 +131,7 @@ func resourceDevTestLabCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	parameters := dtl.Lab{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		LabProperties: &dtl.LabProperties{
 			LabStorageType: dtl.StorageType(storageType),
 		},
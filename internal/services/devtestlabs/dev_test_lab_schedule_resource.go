@@ -213,7 +213,7 @@ func resourceDevTestLabSchedulesCreateUpdate(d *pluginsdk.ResourceData, meta int
 	schedule := dtl.Schedule{
 		Location:           &location,
 		ScheduleProperties: &dtl.ScheduleProperties{},
-		Tags:               tags.Expand(t),
+		Tags:               tags.ExpandWithDefaults(t),
 	}
 
 	switch status := d.Get("status"); status {
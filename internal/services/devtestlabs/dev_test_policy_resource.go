@@ -138,7 +138,7 @@ func resourceArmDevTestPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interf
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := dtl.Policy{
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 		PolicyProperties: &dtl.PolicyProperties{
 			FactName:      dtl.PolicyFactName(id.PolicyName),
 			FactData:      utils.String(factData),
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/sdk/2021-08-01/diskpools"
 	disksValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -109,7 +110,7 @@ func (r DiskPoolResource) Create() sdk.ResourceFunc {
 					SubnetId:          m.SubnetId,
 				},
 				Sku:  expandDisksPoolSku(m.Sku),
-				Tags: tags.Expand(m.Tags),
+				Tags: providerTags.PointerWithDefaults(tags.Expand(m.Tags)),
 			}
 			future, err := client.CreateOrUpdate(ctx, id, createParameter)
 			if err != nil {
@@ -227,8 +228,8 @@ func (r DiskPoolResource) Update() sdk.ResourceFunc {
 				sku := expandDisksPoolSku(m.Sku)
 				patch.Sku = &sku
 			}
-			if metadata.ResourceData.HasChange("tags") {
-				patch.Tags = tags.Expand(m.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				patch.Tags = providerTags.PointerWithDefaults(tags.Expand(m.Tags))
 			}
 
 			future, err := client.Update(ctx, *id, patch)
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -192,7 +193,7 @@ func resourceElasticsearchCreate(d *pluginsdk.ResourceData, meta interface{}) er
 		Sku: &monitorsresource.ResourceSku{
 			Name: d.Get("sku_name").(string),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if err := client.MonitorsCreateThenPoll(ctx, id, body); err != nil {
@@ -318,10 +319,10 @@ func resourceElasticsearchUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		client := meta.(*clients.Client).Elastic.MonitorClient
 		body := monitorsresource.ElasticMonitorResourceUpdateParameters{
-			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+			Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 		}
 		if _, err := client.MonitorsUpdate(ctx, *id, body); err != nil {
 			return fmt.Errorf("updating %s: %+v", *id, err)
@@ -216,7 +216,7 @@ func resourceEventGridDomainCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	domain := eventgrid.Domain{
 		Location:         &location,
 		DomainProperties: domainProperties,
-		Tags:             tags.Expand(t),
+		Tags:             tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("identity"); ok {
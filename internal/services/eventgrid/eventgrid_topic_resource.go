@@ -202,7 +202,7 @@ func resourceEventGridTopicCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	topic := eventgrid.Topic{
 		Location:        &location,
 		TopicProperties: topicProperties,
-		Tags:            tags.Expand(t),
+		Tags:            tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("identity"); ok {
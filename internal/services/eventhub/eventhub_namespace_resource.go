@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -332,7 +333,7 @@ func resourceEventHubNamespaceCreate(d *pluginsdk.ResourceData, meta interface{}
 			DisableLocalAuth:     utils.Bool(disableLocalAuth),
 			PublicNetworkAccess:  &publicNetworkEnabled,
 		},
-		Tags: tags.Expand(t),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(t)),
 	}
 
 	if v := d.Get("dedicated_cluster_id").(string); v != "" {
@@ -423,7 +424,7 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 			DisableLocalAuth:     utils.Bool(disableLocalAuth),
 			PublicNetworkAccess:  &publicNetworkEnabled,
 		},
-		Tags: tags.Expand(t),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(t)),
 	}
 
 	if v := d.Get("dedicated_cluster_id").(string); v != "" {
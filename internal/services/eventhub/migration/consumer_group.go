@@ -1,10 +1,9 @@
 package migration
 
 import (
-	"context"
-	"fmt"
-
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/consumergroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -13,20 +12,15 @@ var _ pluginsdk.StateUpgrade = ConsumerGroupsV0ToV1{}
 type ConsumerGroupsV0ToV1 struct{}
 
 func (ConsumerGroupsV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
-	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-		// old:
-		// 	/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1
-		// new:
-		// 	/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumerGroups/consumergroup1
-		oldId := rawState["id"].(string)
-		parsed, err := consumergroups.ParseConsumerGroupIDInsensitively(oldId)
-		if err != nil {
-			return rawState, fmt.Errorf("parsing existing Consumer Group ID %q: %+v", oldId, err)
-		}
-		rawState["id"] = parsed.ID()
-
-		return rawState, nil
-	}
+	// old:
+	// 	/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1
+	// new:
+	// 	/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumerGroups/consumergroup1
+	return sdk.IDStateUpgrader{
+		Parser: func(input string) (resourceid.Formatter, error) {
+			return consumergroups.ParseConsumerGroupIDInsensitively(input)
+		},
+	}.UpgradeFunc()
 }
 
 func (ConsumerGroupsV0ToV1) Schema() map[string]*pluginsdk.Schema {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fluidrelay/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -139,9 +140,7 @@ func (s Server) Create() sdk.ResourceFunc {
 				Location: azure.NormalizeLocation(model.Location),
 				Name:     utils.String(model.Name),
 			}
-			if model.Tags != nil {
-				serverReq.Tags = &model.Tags
-			}
+			serverReq.Tags = tags.PointerWithDefaults(&model.Tags)
 			serverReq.Properties = &fluidrelayservers.FluidRelayServerProperties{}
 			serverReq.Identity, err = identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
 			if err != nil {
@@ -178,8 +177,8 @@ func (s Server) Update() sdk.ResourceFunc {
 			}
 
 			var upd fluidrelayservers.FluidRelayServerUpdate
-			if meta.ResourceData.HasChange("tags") {
-				upd.Tags = &model.Tags
+			if meta.ResourceData.HasChanges("tags", "tags_all") {
+				upd.Tags = tags.PointerWithDefaults(&model.Tags)
 			}
 			if meta.ResourceData.HasChange("identity") {
 				upd.Identity, err = identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
@@ -30,10 +30,10 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 		resourceGroup := id.ResourceGroup
 		name := id.Name
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			t := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
-				Tags: tags.Expand(t),
+				Tags: tags.ExpandWithDefaults(t),
 			}
 			if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
 				return fmt.Errorf("updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
@@ -269,7 +269,7 @@ func resourceHDInsightHadoopClusterCreate(d *pluginsdk.ResourceData, meta interf
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Identity: identity,
 	}
 
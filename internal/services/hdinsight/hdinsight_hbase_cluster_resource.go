@@ -213,7 +213,7 @@ func resourceHDInsightHBaseClusterCreate(d *pluginsdk.ResourceData, meta interfa
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Identity: identity,
 	}
 
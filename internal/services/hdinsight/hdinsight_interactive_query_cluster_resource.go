@@ -226,7 +226,7 @@ func resourceHDInsightInteractiveQueryClusterCreate(d *pluginsdk.ResourceData, m
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Identity: identity,
 	}
 
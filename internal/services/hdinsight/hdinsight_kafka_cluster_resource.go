@@ -263,7 +263,7 @@ func resourceHDInsightKafkaClusterCreate(d *pluginsdk.ResourceData, meta interfa
 			},
 			KafkaRestProperties: kafkaRestProperty,
 		},
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Identity: identity,
 	}
 
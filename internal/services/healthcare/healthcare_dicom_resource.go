@@ -150,7 +150,7 @@ func resourceHealthcareApisDicomServiceCreate(d *pluginsdk.ResourceData, meta in
 			PublicNetworkAccess: healthcareapis.PublicNetworkAccessEnabled,
 		},
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
 		parameters.DicomServiceProperties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
@@ -245,7 +245,7 @@ func resourceHealthcareApisDicomServiceUpdate(d *pluginsdk.ResourceData, meta in
 		parameters.DicomServiceProperties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := updateTags(d, meta); err != nil {
 			return fmt.Errorf("updating tags error: %+v", err)
 		}
@@ -322,7 +322,7 @@ func updateTags(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	update := healthcareapis.DicomServicePatchResource{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, id.WorkspaceName, update)
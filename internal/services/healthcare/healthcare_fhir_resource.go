@@ -223,7 +223,7 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 		Identity: identity,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Kind:     healthcareapis.FhirServiceKind(d.Get("kind").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		FhirServiceProperties: &healthcareapis.FhirServiceProperties{
 			AuthenticationConfiguration: expandFhirAuthentication(d.Get("authentication").([]interface{})),
 			CorsConfiguration:           expandFhirCorsConfiguration(d.Get("cors").([]interface{})),
@@ -343,7 +343,7 @@ func resourceHealthcareApisFhirServiceUpdate(d *pluginsdk.ResourceData, meta int
 		Identity: identity,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Kind:     healthcareapis.FhirServiceKind(d.Get("kind").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		FhirServiceProperties: &healthcareapis.FhirServiceProperties{
 			AuthenticationConfiguration: expandFhirAuthentication(d.Get("authentication").([]interface{})),
 			CorsConfiguration:           expandFhirCorsConfiguration(d.Get("cors").([]interface{})),
@@ -97,7 +97,7 @@ func resourceHealthcareApisWorkspaceCreate(d *pluginsdk.ResourceData, meta inter
 
 	parameters := healthcareapis.Workspace{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -159,7 +159,7 @@ func resourceHealthcareApisWorkspaceUpdate(d *pluginsdk.ResourceData, meta inter
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := healthcareapis.WorkspacePatchResource{
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
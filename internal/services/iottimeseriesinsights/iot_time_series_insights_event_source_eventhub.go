@@ -138,7 +138,7 @@ func resourceIoTTimeSeriesInsightsEventSourceEventhubCreateUpdate(d *pluginsdk.R
 
 	eventSource := timeseriesinsights.EventHubEventSourceCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		EventHubEventSourceCreationProperties: &timeseriesinsights.EventHubEventSourceCreationProperties{
 			EventHubName:          utils.String(d.Get("eventhub_name").(string)),
 			ServiceBusNamespace:   utils.String(d.Get("namespace_name").(string)),
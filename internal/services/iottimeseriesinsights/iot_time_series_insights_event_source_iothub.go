@@ -133,7 +133,7 @@ func resourceIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate(d *pluginsdk.Res
 
 	eventSource := timeseriesinsights.IoTHubEventSourceCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		IoTHubEventSourceCreationProperties: &timeseriesinsights.IoTHubEventSourceCreationProperties{
 			IotHubName:            utils.String(d.Get("iothub_name").(string)),
 			SharedAccessKey:       utils.String(d.Get("shared_access_key").(string)),
@@ -143,7 +143,7 @@ func resourceIoTTimeSeriesInsightsGen2EnvironmentCreateUpdate(d *pluginsdk.Resou
 
 	environment := timeseriesinsights.Gen2EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Sku:      sku,
 		Gen2EnvironmentCreationProperties: &timeseriesinsights.Gen2EnvironmentCreationProperties{
 			TimeSeriesIDProperties: expandIdProperties(d.Get("id_properties").([]interface{})),
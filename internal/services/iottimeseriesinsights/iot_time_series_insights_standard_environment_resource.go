@@ -146,7 +146,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *pluginsdk.R
 
 	environment := timeseriesinsights.Gen1EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Sku:      sku,
 		Gen1EnvironmentCreationProperties: &timeseriesinsights.Gen1EnvironmentCreationProperties{
 			StorageLimitExceededBehavior: timeseriesinsights.StorageLimitExceededBehavior(d.Get("storage_limit_exceeded_behavior").(string)),
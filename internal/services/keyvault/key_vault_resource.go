@@ -320,7 +320,7 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 			// documentation with further details
 			EnableSoftDelete: utils.Bool(true),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if d.Get("public_network_access_enabled").(bool) {
@@ -588,9 +588,9 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		update.Properties.TenantID = &tenantUUID
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(t)
+		update.Tags = tags.ExpandWithDefaults(t)
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
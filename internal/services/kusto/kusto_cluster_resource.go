@@ -402,7 +402,7 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		Identity:          expandedIdentity,
 		Sku:               sku,
 		ClusterProperties: &clusterProperties,
-		Tags:              tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:              tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	zones := zones.Expand(d.Get("zones").(*schema.Set).List())
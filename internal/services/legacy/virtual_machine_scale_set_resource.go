@@ -905,7 +905,7 @@ func resourceVirtualMachineScaleSetCreateUpdate(d *pluginsdk.ResourceData, meta
 	properties := compute.VirtualMachineScaleSet{
 		Name:                             &id.Name,
 		Location:                         &location,
-		Tags:                             tags.Expand(t),
+		Tags:                             tags.ExpandWithDefaults(t),
 		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 		Zones:                            zones,
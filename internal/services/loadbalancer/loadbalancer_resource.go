@@ -83,7 +83,7 @@ func resourceArmLoadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tier: network.LoadBalancerSkuTier(d.Get("sku_tier").(string)),
 	}
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(t)
 
 	properties := network.LoadBalancerPropertiesFormat{}
 
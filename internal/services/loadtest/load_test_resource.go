@@ -89,7 +89,7 @@ func (r LoadTestResource) Create() sdk.ResourceFunc {
 			loadTest := loadtests.LoadTestResource{
 				Name:     &model.Name,
 				Location: model.Location,
-				Tags:     &model.Tags,
+				Tags:     tags.PointerWithDefaults(&model.Tags),
 			}
 
 			_, err = client.CreateOrUpdate(ctx, id, loadTest)
@@ -113,8 +113,8 @@ func (r LoadTestResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			var state LoadTestResourceModel
-			if err := metadata.Decode(&state); err != nil {
+			var config LoadTestResourceModel
+			if err := metadata.Decode(&config); err != nil {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
@@ -122,9 +122,14 @@ func (r LoadTestResource) Update() sdk.ResourceFunc {
 			if err != nil {
 				return fmt.Errorf("reading Load Test %s: %v", id, err)
 			}
+			if existing.Model == nil {
+				return fmt.Errorf("reading Load Test %s: model was nil", id)
+			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Model.Tags = &state.Tags
+			// `tags` only contains the tags specified for this resource, so the default tags must be merged in
+			// (and a change to `default_tags` in the Provider block only shows up in `tags_all`)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Model.Tags = tags.PointerWithDefaults(&config.Tags)
 			}
 
 			_, err = client.CreateOrUpdate(ctx, *id, *existing.Model)
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -86,7 +87,7 @@ func (r LogAnalyticsQueryPackResource) Create() sdk.ResourceFunc {
 			properties := &querypacks.LogAnalyticsQueryPack{
 				Location:   location.Normalize(model.Location),
 				Properties: querypacks.LogAnalyticsQueryPackProperties{},
-				Tags:       &model.Tags,
+				Tags:       tags.PointerWithDefaults(&model.Tags),
 			}
 
 			if resp, err := client.QueryPacksCreateOrUpdate(ctx, id, *properties); err != nil {
@@ -128,8 +129,8 @@ func (r LogAnalyticsQueryPackResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: properties was nil", id)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				properties.Tags = &model.Tags
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				properties.Tags = tags.PointerWithDefaults(&model.Tags)
 			}
 
 			if resp, err := client.QueryPacksCreateOrUpdate(ctx, *id, *properties); err != nil {
@@ -223,7 +223,7 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta i
 	parameters := operationalinsights.Workspace{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		WorkspaceProperties: &operationalinsights.WorkspaceProperties{
 			Sku:                             sku,
 			PublicNetworkAccessForIngestion: internetIngestionEnabled,
@@ -97,7 +97,7 @@ func resourceLogicAppIntegrationAccountCreateUpdate(d *pluginsdk.ResourceData, m
 		Sku: &logic.IntegrationAccountSku{
 			Name: logic.IntegrationAccountSkuName(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("integration_service_environment_id"); ok {
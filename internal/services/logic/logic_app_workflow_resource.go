@@ -336,7 +336,7 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 			Parameters: parameters,
 			State:      isEnabled,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("access_control"); ok {
@@ -425,7 +425,7 @@ func resourceLogicAppWorkflowUpdate(d *pluginsdk.ResourceData, meta interface{})
 			Parameters: parameters,
 			State:      isEnabled,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("access_control"); ok {
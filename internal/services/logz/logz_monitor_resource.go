@@ -168,7 +168,7 @@ func resourceLogzMonitorCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 			UserInfo:                   expandUserInfo(d.Get("user").([]interface{})),
 			MonitoringStatus:           monitoringStatus,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, id.ResourceGroup, id.MonitorName, &props)
@@ -246,8 +246,8 @@ func resourceLogzMonitorUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		body.Properties.MonitoringStatus = monitoringStatus
 	}
 
-	if d.HasChange("tags") {
-		body.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		body.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.MonitorName, &body); err != nil {
@@ -102,7 +102,7 @@ func resourceLogzSubAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			UserInfo:         expandUserInfo(d.Get("user").([]interface{})),
 			MonitoringStatus: monitoringStatus,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if properties := resp.Properties; properties != nil {
@@ -177,8 +177,8 @@ func resourceLogzSubAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		props.Properties.MonitoringStatus = monitoringStatus
 	}
 
-	if d.HasChange("tags") {
-		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		props.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.MonitorName, id.AccountName, &props); err != nil {
@@ -210,7 +210,7 @@ func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		Properties: computeClusterProperties,
 		Identity:   identity,
 		Location:   computeClusterProperties.ComputeLocation,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		Sku:        workspace.Sku,
 	}
 
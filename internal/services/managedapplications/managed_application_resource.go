@@ -161,7 +161,7 @@ func resourceManagedApplicationCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	parameters := managedapplications.Application{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location"))),
 		Kind:     utils.String(d.Get("kind").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("managed_resource_group_name"); ok {
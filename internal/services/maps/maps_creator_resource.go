@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maps/2021-02-01/creators"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -93,7 +94,7 @@ func resourceMapsCreatorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Properties: creators.CreatorProperties{
 			StorageUnits: int64(d.Get("storage_units").(int)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -151,7 +152,7 @@ func resourceMapsCreatorUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Properties: &creators.CreatorProperties{
 			StorageUnits: int64(d.Get("storage_units").(int)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 	}
 
 	if _, err := client.Update(ctx, *id, props); err != nil {
@@ -160,7 +160,7 @@ func resourceMediaServicesAccountCreateUpdate(d *pluginsdk.ResourceData, meta in
 		ServiceProperties: &media.ServiceProperties{
 			StorageAccounts: storageAccounts,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("storage_authentication_type"); ok {
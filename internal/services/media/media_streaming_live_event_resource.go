@@ -370,7 +370,7 @@ func resourceMediaLiveEventCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	parameters := media.LiveEvent{
 		LiveEventProperties: &media.LiveEventProperties{},
 		Location:            utils.String(location),
-		Tags:                tags.Expand(t),
+		Tags:                tags.ExpandWithDefaults(t),
 	}
 
 	autoStart := utils.Bool(false)
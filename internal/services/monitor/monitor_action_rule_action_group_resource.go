@@ -135,7 +135,7 @@ func resourceMonitorActionRuleActionGroupCreateUpdate(d *pluginsdk.ResourceData,
 			Status:        actionRuleStatus,
 			Type:          alertsmanagement.TypeActionGroup,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateUpdate(ctx, id.ResourceGroup, id.Name, actionRule); err != nil {
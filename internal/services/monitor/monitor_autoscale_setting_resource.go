@@ -438,7 +438,7 @@ func resourceMonitorAutoScaleSettingCreateUpdate(d *pluginsdk.ResourceData, meta
 	}
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(t)
 
 	parameters := insights.AutoscaleSettingResource{
 		Location: utils.String(location),
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -113,7 +114,7 @@ func (r DataCollectionEndpointResource) Create() sdk.ResourceFunc {
 						PublicNetworkAccess: expandDataCollectionEndpointPublicNetworkAccess(state.EnablePublicNetworkAccess),
 					},
 				},
-				Tags: tags.Expand(state.Tags),
+				Tags: providerTags.PointerWithDefaults(tags.Expand(state.Tags)),
 			}
 
 			if _, err := client.Create(ctx, id, input); err != nil {
@@ -215,8 +216,8 @@ func (r DataCollectionEndpointResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.Expand(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = providerTags.PointerWithDefaults(tags.Expand(state.Tags))
 			}
 
 			if _, err := client.Create(ctx, *id, *existing); err != nil {
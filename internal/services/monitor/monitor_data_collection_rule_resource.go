@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -401,7 +402,7 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 					Description:  utils.String(state.Description),
 					Destinations: expandDataCollectionRuleDestinations(state.Destinations),
 				},
-				Tags: tags.Expand(state.Tags),
+				Tags: providerTags.PointerWithDefaults(tags.Expand(state.Tags)),
 			}
 
 			if _, err := client.Create(ctx, id, input); err != nil {
@@ -499,8 +500,8 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 				existing.Kind = expandDataCollectionRuleKind(state.Kind)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.Expand(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = providerTags.PointerWithDefaults(tags.Expand(state.Tags))
 			}
 
 			if metadata.ResourceData.HasChange("data_flow") {
@@ -229,7 +229,7 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		Name:     &id.Name,
 		Location: &location,
 		Sku:      sku,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		ElasticPoolProperties: &sql.ElasticPoolProperties{
 			LicenseType:                sql.ElasticPoolLicenseType(d.Get("license_type").(string)),
 			PerDatabaseSettings:        expandMsSqlElasticPoolPerDatabaseSettings(d),
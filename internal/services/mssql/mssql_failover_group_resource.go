@@ -214,7 +214,7 @@ func (r MsSqlFailoverGroupResource) Create() sdk.ResourceFunc {
 					ReadWriteEndpoint: &sql.FailoverGroupReadWriteEndpoint{},
 					PartnerServers:    r.expandPartnerServers(model.PartnerServers),
 				},
-				Tags: tags.FromTypedObject(model.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(model.Tags)),
 			}
 
 			if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
@@ -274,7 +274,7 @@ func (r MsSqlFailoverGroupResource) Update() sdk.ResourceFunc {
 					},
 					PartnerServers: r.expandPartnerServers(state.PartnerServers),
 				},
-				Tags: tags.FromTypedObject(state.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(state.Tags)),
 			}
 
 			if state.ReadWriteEndpointFailurePolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) {
@@ -315,7 +315,7 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 					TimezoneID:                 utils.String(model.TimezoneId),
 					VCores:                     utils.Int32(int32(model.VCores)),
 				},
-				Tags: tags.FromTypedObject(model.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(model.Tags)),
 			}
 
 			metadata.Logger.Infof("Creating %s", id)
@@ -376,7 +376,7 @@ func (r MsSqlManagedInstanceResource) Update() sdk.ResourceFunc {
 					StorageSizeInGB:           utils.Int32(int32(state.StorageSizeInGb)),
 					VCores:                    utils.Int32(int32(state.VCores)),
 				},
-				Tags: tags.FromTypedObject(state.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(state.Tags)),
 			}
 
 			if metadata.ResourceData.HasChange("maintenance_configuration_name") {
@@ -1574,7 +1574,7 @@ func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{
 
 	gateway := network.ApplicationGateway{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		ApplicationGatewayPropertiesFormat: &network.ApplicationGatewayPropertiesFormat{
 			AutoscaleConfiguration:        expandApplicationGatewayAutoscaleConfiguration(d),
 			AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d.Get("authentication_certificate").([]interface{})),
@@ -1721,8 +1721,8 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	if d.HasChange("tags") {
-		applicationGateway.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		applicationGateway.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
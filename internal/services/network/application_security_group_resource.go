@@ -76,7 +76,7 @@ func resourceApplicationSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, met
 
 	securityGroup := network.ApplicationSecurityGroup{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, securityGroup)
 	if err != nil {
@@ -183,7 +183,7 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 	sku := expandExpressRouteCircuitSku(d)
 	t := d.Get("tags").(map[string]interface{})
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(t)
 
 	// There is the potential for the express route circuit to become out of sync when the service provider updates
 	// the express route circuit. We'll get and update the resource in place as per https://aka.ms/erRefresh
@@ -268,7 +268,7 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		ExtendedLocation:          expandEdgeZone(d.Get("edge_zone").(string)),
 		Location:                  utils.String(location),
 		InterfacePropertiesFormat: &properties,
-		Tags:                      tags.Expand(t),
+		Tags:                      tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
@@ -364,9 +364,9 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		update.InterfacePropertiesFormat.IPConfigurations = existing.InterfacePropertiesFormat.IPConfigurations
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
+		update.Tags = tags.ExpandWithDefaults(tagsRaw)
 	} else {
 		update.Tags = existing.Tags
 	}
@@ -129,7 +129,7 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			PrefixLength:           utils.Int32(int32(prefixLength)),
 			PublicIPAddressVersion: network.IPVersion(ipVersion),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	zones := zones.Expand(d.Get("zones").(*schema.Set).List())
@@ -129,7 +129,7 @@ func resourceRouteServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	location := location.Normalize(d.Get("location").(string))
-	t := tags.Expand(d.Get("tags").(map[string]interface{}))
+	t := tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 
 	parameters := network.VirtualHub{
 		Location: utils.String(location),
@@ -159,7 +159,7 @@ func resourceVirtualHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		VirtualHubProperties: &network.VirtualHubProperties{
 			RouteTable: expandVirtualHubRoute(route),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("address_prefix"); ok {
@@ -96,7 +96,7 @@ func resourceVirtualHubSecurityPartnerProviderCreate(d *pluginsdk.ResourceData,
 		SecurityPartnerProviderPropertiesFormat: &network.SecurityPartnerProviderPropertiesFormat{
 			SecurityProviderName: network.SecurityProviderName(d.Get("security_provider_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("virtual_hub_id"); ok {
@@ -166,8 +166,8 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *pluginsdk.ResourceData,
 
 	parameters := network.TagsObject{}
 
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		parameters.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
@@ -387,7 +387,7 @@ func resourceVirtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceDa
 	connection := network.VirtualNetworkGatewayConnection{
 		Name:     &id.ConnectionName,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
 	}
 
@@ -425,7 +425,7 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 		Name:                                  &id.Name,
 		ExtendedLocation:                      expandEdgeZone(d.Get("edge_zone").(string)),
 		Location:                              &location,
-		Tags:                                  tags.Expand(t),
+		Tags:                                  tags.ExpandWithDefaults(t),
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -48,6 +49,14 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"virtual_network_name": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
@@ -88,8 +97,12 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 }
 
 func resourceVirtualNetworkPeeringCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	subscriptionClient, err := meta.(*clients.Client).ForSubscription(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	client := subscriptionClient.Network.VnetPeeringsClient
+	subscriptionId := subscriptionClient.Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +131,7 @@ func resourceVirtualNetworkPeeringCreateUpdate(d *pluginsdk.ResourceData, meta i
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	if err := pluginsdk.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(d, id.ResourceGroup, id.VirtualNetworkName, id.Name, peer, meta)); err != nil {
+	if err := pluginsdk.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(d, id.ResourceGroup, id.VirtualNetworkName, id.Name, peer, subscriptionClient)); err != nil {
 		return err
 	}
 
@@ -128,7 +141,6 @@ func resourceVirtualNetworkPeeringCreateUpdate(d *pluginsdk.ResourceData, meta i
 }
 
 func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,6 +149,12 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	subscriptionClient, err := meta.(*clients.Client).ForSubscription(id.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	client := subscriptionClient.Network.VnetPeeringsClient
+
 	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -148,6 +166,7 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 
 	// update appropriate values
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("subscription_id", id.SubscriptionId)
 	d.Set("name", id.Name)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
 
@@ -165,7 +184,6 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 }
 
 func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,6 +192,12 @@ func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	subscriptionClient, err := meta.(*clients.Client).ForSubscription(id.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	client := subscriptionClient.Network.VnetPeeringsClient
+
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
@@ -191,7 +191,7 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		ExtendedLocation:               expandEdgeZone(d.Get("edge_zone").(string)),
 		Location:                       utils.String(location),
 		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags:                           tags.Expand(t),
+		Tags:                           tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("flow_timeout_in_minutes"); ok {
@@ -420,7 +420,7 @@ func resourceVPNServerConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta
 	parameters := network.VpnServerConfiguration{
 		Location:                         utils.String(location),
 		VpnServerConfigurationProperties: &props,
-		Tags:                             tags.Expand(t),
+		Tags:                             tags.ExpandWithDefaults(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -212,7 +212,7 @@ func resourceVpnSiteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 			VpnSiteLinks:     expandVpnSiteLinks(d.Get("link").([]interface{})),
 			O365Policy:       expandVpnSiteO365Policy(d.Get("o365_policy").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, param)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	providerTags "github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -58,6 +59,14 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
 			// TODO: make this case sensitive once the API's fixed https://github.com/Azure/azure-rest-api-specs/issues/10933
 			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
 
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"virtual_network_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
@@ -77,8 +86,12 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
 }
 
 func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	subscriptionClient, err := meta.(*clients.Client).ForSubscription(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	client := subscriptionClient.PrivateDns.VirtualNetworkLinksClient
+	subscriptionId := subscriptionClient.Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -98,7 +111,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceD
 
 	parameters := virtualnetworklinks.VirtualNetworkLink{
 		Location: utils.String("global"),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     providerTags.PointerWithDefaults(tags.Expand(d.Get("tags").(map[string]interface{}))),
 		Properties: &virtualnetworklinks.VirtualNetworkLinkProperties{
 			VirtualNetwork: &virtualnetworklinks.SubResource{
 				Id: utils.String(d.Get("virtual_network_id").(string)),
@@ -142,6 +155,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkRead(d *pluginsdk.ResourceData, met
 	d.Set("name", id.VirtualNetworkLinkName)
 	d.Set("private_dns_zone_name", id.PrivateZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("subscription_id", id.SubscriptionId)
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
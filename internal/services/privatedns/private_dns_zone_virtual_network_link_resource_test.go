@@ -79,6 +79,24 @@ func TestAccPrivateDnsZoneVirtualNetworkLink_withTags(t *testing.T) {
 	})
 }
 
+func TestAccPrivateDnsZoneVirtualNetworkLink_crossSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_link", "test")
+	if data.Subscriptions.Secondary == "" {
+		t.Skipf("The secondary subscription is not specified")
+	}
+	r := PrivateDnsZoneVirtualNetworkLinkResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Subscriptions.Secondary),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t PrivateDnsZoneVirtualNetworkLinkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := virtualnetworklinks.ParseVirtualNetworkLinkID(state.ID)
 	if err != nil {
@@ -130,6 +148,56 @@ resource "azurerm_private_dns_zone_virtual_network_link" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (PrivateDnsZoneVirtualNetworkLinkResource) crossSubscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azurerm-alt" {
+  subscription_id = "%[1]s"
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "vnet%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+
+  subnet {
+    name           = "subnet1"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+
+resource "azurerm_resource_group" "dns" {
+  provider = azurerm-alt
+  name     = "acctestRG-dns-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  provider            = azurerm-alt
+  name                = "acctestzone%[2]d.com"
+  resource_group_name = azurerm_resource_group.dns.name
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "test" {
+  name                  = "acctestVnetZone%[2]d.com"
+  subscription_id       = "%[1]s"
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  virtual_network_id    = azurerm_virtual_network.test.id
+  resource_group_name   = azurerm_resource_group.dns.name
+}
+`, data.Subscriptions.Secondary, data.RandomInteger, data.Locations.Primary)
+}
+
 func (PrivateDnsZoneVirtualNetworkLinkResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -168,7 +168,7 @@ func resourceRecoveryServicesVaultCreate(d *pluginsdk.ResourceData, meta interfa
 	sku := d.Get("sku").(string)
 	vault := recoveryservices.Vault{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		Identity: expandedIdentity,
 		Sku: &recoveryservices.Sku{
 			Name: recoveryservices.SkuName(sku),
@@ -453,8 +453,8 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 		vault.Properties.Encryption = encryption
 	}
 
-	if d.HasChange("tags") {
-		vault.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		vault.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	updateFuture, err := client.Update(ctx, id.ResourceGroup, id.Name, vault)
@@ -40,6 +40,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(managementGroupTemplateDeploymentWhatIf, "name", "management_group_id", "location")),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		//lintignore:S033
@@ -95,6 +97,12 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -104,6 +112,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -136,7 +146,7 @@ func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData,
 			DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
 			Mode:         resources.DeploymentModeIncremental,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if templateRaw, ok := d.GetOk("template_content"); ok {
@@ -246,8 +256,8 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		deployment.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Management Group Template Deployment %q..", id.DeploymentName)
@@ -300,6 +310,7 @@ func managementGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, me
 	managementGroupId := mgParse.NewManagementGroupId(id.ManagementGroupName)
 	d.Set("management_group_id", managementGroupId.ID())
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	setTemplateDeploymentWhatIf(d)
 
 	if props := resp.Properties; props != nil {
 		d.Set("debug_level", flattenTemplateDeploymentDebugSetting(props.DebugSetting))
@@ -363,6 +374,23 @@ func managementGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func managementGroupTemplateDeploymentWhatIf(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+
+	managementGroupId, err := mgParse.ManagementGroupID(diff.Get("management_group_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := resources.ScopedDeploymentWhatIf{
+		Location:   utils.String(location.Normalize(diff.Get("location").(string))),
+		Properties: &properties,
+	}
+
+	future, err := client.WhatIfAtManagementGroupScope(ctx, managementGroupId.Name, diff.Get("name").(string), parameters)
+	return templateDeploymentWhatIfResult(ctx, client, future.FutureAPI, future.Result, err)
+}
+
 func validateManagementGroupTemplateDeployment(ctx context.Context, id parse.ManagementGroupTemplateDeploymentId, deployment resources.ScopedDeployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.ValidateAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName, deployment)
 	if err != nil {
@@ -37,6 +37,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(resourceGroupTemplateDeploymentWhatIf, "name", "resource_group_name", "deployment_mode")),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		//lintignore:S033
@@ -94,6 +96,12 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -103,6 +111,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -130,7 +140,7 @@ func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, me
 			DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
 			Mode:         resources.DeploymentMode(d.Get("deployment_mode").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if templateRaw, ok := d.GetOk("template_content"); ok {
@@ -241,8 +251,8 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		deployment.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
@@ -293,6 +303,7 @@ func resourceGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta
 
 	d.Set("name", id.DeploymentName)
 	d.Set("resource_group_name", id.ResourceGroup)
+	setTemplateDeploymentWhatIf(d)
 
 	if props := resp.Properties; props != nil {
 		d.Set("debug_level", flattenTemplateDeploymentDebugSetting(props.DebugSetting))
@@ -379,6 +390,18 @@ func resourceGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, me
 	return nil
 }
 
+func resourceGroupTemplateDeploymentWhatIf(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+
+	properties.Mode = resources.DeploymentMode(diff.Get("deployment_mode").(string))
+	parameters := resources.DeploymentWhatIf{
+		Properties: &properties,
+	}
+
+	future, err := client.WhatIf(ctx, diff.Get("resource_group_name").(string), diff.Get("name").(string), parameters)
+	return templateDeploymentWhatIfResult(ctx, client, future.FutureAPI, future.Result, err)
+}
+
 func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.ResourceGroupTemplateDeploymentId, deployment resources.Deployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.Validate(ctx, id.ResourceGroup, id.DeploymentName, deployment)
 	if err != nil {
@@ -38,6 +38,8 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(subscriptionTemplateDeploymentWhatIf, "name", "location")),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		//lintignore:S033
@@ -86,6 +88,12 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -95,6 +103,8 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -123,7 +133,7 @@ func subscriptionTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, met
 			DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
 			Mode:         resources.DeploymentModeIncremental,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if templateRaw, ok := d.GetOk("template_content"); ok {
@@ -231,8 +241,8 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		deployment.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Subscription Template Deployment %q..", id.DeploymentName)
@@ -283,6 +293,7 @@ func subscriptionTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta
 
 	d.Set("name", id.DeploymentName)
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	setTemplateDeploymentWhatIf(d)
 
 	if props := resp.Properties; props != nil {
 		d.Set("debug_level", flattenTemplateDeploymentDebugSetting(props.DebugSetting))
@@ -346,6 +357,18 @@ func subscriptionTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, met
 	return nil
 }
 
+func subscriptionTemplateDeploymentWhatIf(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+
+	parameters := resources.DeploymentWhatIf{
+		Location:   utils.String(location.Normalize(diff.Get("location").(string))),
+		Properties: &properties,
+	}
+
+	future, err := client.WhatIfAtSubscriptionScope(ctx, diff.Get("name").(string), parameters)
+	return templateDeploymentWhatIfResult(ctx, client, future.FutureAPI, future.Result, err)
+}
+
 func validateSubscriptionTemplateDeployment(ctx context.Context, id parse.SubscriptionTemplateDeploymentId, deployment resources.Deployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.ValidateAtSubscriptionScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
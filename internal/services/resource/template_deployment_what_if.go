@@ -0,0 +1,207 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// templateDeploymentWhatIfFunc runs the What-If operation for the Template Deployment described by the diff,
+// returning the result of the operation, or nil if the result can't be determined at this time
+type templateDeploymentWhatIfFunc func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error)
+
+func templateDeploymentWhatIfChangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"change_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"changed_properties": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// templateDeploymentWhatIfCustomizeDiff runs the What-If operation during the plan when `what_if_enabled` is set, exposing
+// the predicted changes to the resources within the scope of the deployment in `what_if_changes`
+func templateDeploymentWhatIfCustomizeDiff(whatIf templateDeploymentWhatIfFunc, requiredKeys ...string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !diff.Get("what_if_enabled").(bool) {
+			return clearTemplateDeploymentWhatIfChanges(diff)
+		}
+
+		// the prediction only needs updating when the contents of the deployment have changed - otherwise any
+		// previous prediction no longer applies
+		changeKeys := append([]string{"template_content", "template_spec_version_id", "parameters_content", "what_if_enabled"}, requiredKeys...)
+		if diff.Id() != "" && !diff.HasChanges(changeKeys...) {
+			return clearTemplateDeploymentWhatIfChanges(diff)
+		}
+
+		for _, key := range append([]string{"template_content", "template_spec_version_id", "parameters_content"}, requiredKeys...) {
+			if !diff.NewValueKnown(key) {
+				log.Printf("[DEBUG] Skipping the What-If operation for the Template Deployment since %q isn't known until apply", key)
+				return diff.SetNewComputed("what_if_changes")
+			}
+		}
+
+		properties := resources.DeploymentWhatIfProperties{
+			Mode: resources.DeploymentModeIncremental,
+			WhatIfSettings: &resources.DeploymentWhatIfSettings{
+				ResultFormat: resources.WhatIfResultFormatFullResourcePayloads,
+			},
+		}
+
+		if v := diff.Get("template_spec_version_id").(string); v != "" {
+			properties.TemplateLink = &resources.TemplateLink{
+				ID: utils.String(v),
+			}
+		} else {
+			template, err := expandTemplateDeploymentBody(diff.Get("template_content").(string))
+			if err != nil {
+				return fmt.Errorf("expanding `template_content`: %+v", err)
+			}
+			properties.Template = template
+		}
+
+		if v := diff.Get("parameters_content").(string); v != "" {
+			parameters, err := expandTemplateDeploymentBody(v)
+			if err != nil {
+				return fmt.Errorf("expanding `parameters_content`: %+v", err)
+			}
+			properties.Parameters = parameters
+		}
+
+		log.Printf("[DEBUG] Running the What-If operation for the Template Deployment..")
+		result, err := whatIf(ctx, diff, meta, properties)
+		if err != nil {
+			return fmt.Errorf("running the What-If operation for the Template Deployment: %+v", err)
+		}
+		if result == nil {
+			return diff.SetNewComputed("what_if_changes")
+		}
+		if result.Error != nil {
+			if result.Error.Message != nil {
+				return fmt.Errorf("running the What-If operation for the Template Deployment: %s", *result.Error.Message)
+			}
+			return fmt.Errorf("running the What-If operation for the Template Deployment: %+v", *result.Error)
+		}
+
+		return diff.SetNew("what_if_changes", flattenTemplateDeploymentWhatIfChanges(result.WhatIfOperationProperties))
+	}
+}
+
+// clearTemplateDeploymentWhatIfChanges removes any predicted changes from a previous plan
+func clearTemplateDeploymentWhatIfChanges(diff *pluginsdk.ResourceDiff) error {
+	if len(diff.Get("what_if_changes").([]interface{})) == 0 {
+		return nil
+	}
+	return diff.SetNew("what_if_changes", []interface{}{})
+}
+
+// setTemplateDeploymentWhatIf sets `what_if_enabled` so that it's populated when importing, and clears `what_if_changes`
+// since the predicted changes are only relevant to the plan in which they were computed
+func setTemplateDeploymentWhatIf(d *pluginsdk.ResourceData) {
+	d.Set("what_if_enabled", d.Get("what_if_enabled").(bool))
+	d.Set("what_if_changes", []interface{}{})
+}
+
+// templateDeploymentWhatIfResult waits for the What-If operation to complete, returning nil when the scope of the
+// deployment doesn't exist yet (e.g. the Resource Group is created in the same apply) - since there's nothing to compare against
+func templateDeploymentWhatIfResult(ctx context.Context, client *resources.DeploymentsClient, future azure.FutureAPI, result func(resources.DeploymentsClient) (resources.WhatIfOperationResult, error), err error) (*resources.WhatIfOperationResult, error) {
+	if err != nil {
+		if detailed, ok := err.(autorest.DetailedError); ok && detailed.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] The scope for the Template Deployment was not found - the changes will be known after apply")
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for the What-If operation: %+v", err)
+	}
+	output, err := result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the result of the What-If operation: %+v", err)
+	}
+	return &output, nil
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	changes := make([]resources.WhatIfChange, 0)
+	for _, change := range *input.Changes {
+		// resources which are unchanged or ignored aren't relevant to the plan
+		if change.ChangeType == resources.ChangeTypeNoChange || change.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return strings.ToLower(utils.NormalizeNilableString(changes[i].ResourceID)) < strings.ToLower(utils.NormalizeNilableString(changes[j].ResourceID))
+	})
+
+	for _, change := range changes {
+		changedProperties := make([]interface{}, 0)
+		if change.Delta != nil {
+			for _, v := range flattenTemplateDeploymentWhatIfPropertyChanges("", *change.Delta) {
+				changedProperties = append(changedProperties, v)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":        utils.NormalizeNilableString(change.ResourceID),
+			"change_type":        string(change.ChangeType),
+			"changed_properties": changedProperties,
+		})
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentWhatIfPropertyChanges returns the paths of the (leaf) properties which will change,
+// along with the type of change - for example `properties.sku.name (Modify)`
+func flattenTemplateDeploymentWhatIfPropertyChanges(prefix string, input []resources.WhatIfPropertyChange) []string {
+	output := make([]string, 0)
+	for _, change := range input {
+		path := utils.NormalizeNilableString(change.Path)
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, path)
+		}
+
+		if change.Children != nil && len(*change.Children) > 0 {
+			output = append(output, flattenTemplateDeploymentWhatIfPropertyChanges(path, *change.Children)...)
+			continue
+		}
+
+		output = append(output, fmt.Sprintf("%s (%s)", path, string(change.PropertyChangeType)))
+	}
+	return output
+}
@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func testTemplateDeploymentWhatIfResource(calls *int) *pluginsdk.Resource {
+	whatIf := func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		*calls++
+		return &resources.WhatIfOperationResult{
+			WhatIfOperationProperties: &resources.WhatIfOperationProperties{
+				Changes: &[]resources.WhatIfChange{
+					{
+						ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"),
+						ChangeType: resources.ChangeTypeCreate,
+					},
+				},
+			},
+		}, nil
+	}
+
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"template_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"template_spec_version_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"parameters_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
+		},
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(whatIf)),
+	}
+}
+
+func TestTemplateDeploymentWhatIfCustomizeDiff(t *testing.T) {
+	calls := 0
+	resource := testTemplateDeploymentWhatIfResource(&calls)
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                                     "example",
+			"template_content":                       "{}",
+			"what_if_enabled":                        "true",
+			"what_if_changes.#":                      "1",
+			"what_if_changes.0.resource_id":          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			"what_if_changes.0.change_type":          "Modify",
+			"what_if_changes.0.changed_properties.#": "0",
+		},
+	}
+
+	// a previous prediction is cleared when the contents of the deployment are unchanged
+	diff, err := resource.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"template_content": "{}",
+		"what_if_enabled":  true,
+	}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected the What-If operation not to be run when the deployment is unchanged")
+	}
+	if diff == nil || diff.Attributes["what_if_changes.#"] == nil || diff.Attributes["what_if_changes.#"].New != "0" {
+		t.Fatalf("expected `what_if_changes` to be cleared but got %+v", diff)
+	}
+
+	// whereas a change to the deployment runs the What-If operation
+	diff, err = resource.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"template_content": `{"updated":true}`,
+		"what_if_enabled":  true,
+	}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected the What-If operation to be run once but got %d", calls)
+	}
+	if diff == nil || diff.Attributes["what_if_changes.0.change_type"] == nil || diff.Attributes["what_if_changes.0.change_type"].New != "Create" {
+		t.Fatalf("expected `what_if_changes` to contain the predicted change but got %+v", diff)
+	}
+}
@@ -38,6 +38,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentWhatIfCustomizeDiff(tenantTemplateDeploymentWhatIf, "name", "location")),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		//lintignore:S033
@@ -86,6 +88,12 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed
@@ -95,6 +103,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -122,7 +132,7 @@ func tenantTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta inte
 			DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
 			Mode:         resources.DeploymentModeIncremental,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	if templateRaw, ok := d.GetOk("template_content"); ok {
@@ -232,8 +242,8 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		deployment.Tags = tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Tenant Template Deployment %q..", id.DeploymentName)
@@ -284,6 +294,7 @@ func tenantTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta interf
 
 	d.Set("name", id.DeploymentName)
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	setTemplateDeploymentWhatIf(d)
 
 	if props := resp.Properties; props != nil {
 		d.Set("debug_level", flattenTemplateDeploymentDebugSetting(props.DebugSetting))
@@ -347,6 +358,18 @@ func tenantTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+func tenantTemplateDeploymentWhatIf(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+
+	parameters := resources.ScopedDeploymentWhatIf{
+		Location:   utils.String(location.Normalize(diff.Get("location").(string))),
+		Properties: &properties,
+	}
+
+	future, err := client.WhatIfAtTenantScope(ctx, diff.Get("name").(string), parameters)
+	return templateDeploymentWhatIfResult(ctx, client, future.FutureAPI, future.Result, err)
+}
+
 func validateTenantTemplateDeployment(ctx context.Context, id parse.TenantTemplateDeploymentId, deployment resources.ScopedDeployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.ValidateAtTenantScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
@@ -0,0 +1,111 @@
+package azuresdkhacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NrtAlertRuleModel is an NRT Alert Rule alongside its Event Grouping Settings, which are supported by the API
+// but aren't present within securityinsight.NrtAlertRuleProperties in the SDK
+type NrtAlertRuleModel struct {
+	securityinsight.AlertRuleModel
+
+	EventGroupingSettings *securityinsight.EventGroupingSettings
+}
+
+func CreateOrUpdateNrtAlertRule(ctx context.Context, client *securityinsight.AlertRulesClient, resourceGroupName, workspaceName, ruleID string, input securityinsight.NrtAlertRule, eventGroupingSettings *securityinsight.EventGroupingSettings) (result securityinsight.AlertRuleModel, err error) {
+	// NOTE: the SDK marshals the NRT Alert Rule without the Event Grouping Settings, so the request is prepared
+	// using the SDK and the Event Grouping Settings are then added to the properties within the body
+	req, err := client.CreateOrUpdatePreparer(ctx, resourceGroupName, workspaceName, ruleID, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.AlertRulesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, fmt.Errorf("creating NRT Alert Rule: %+v", err)
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return result, fmt.Errorf("marshalling NRT Alert Rule: %+v", err)
+	}
+	payload := map[string]interface{}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return result, fmt.Errorf("unmarshalling NRT Alert Rule: %+v", err)
+	}
+	if eventGroupingSettings != nil {
+		properties, ok := payload["properties"].(map[string]interface{})
+		if !ok {
+			properties = map[string]interface{}{}
+		}
+		properties["eventGroupingSettings"] = eventGroupingSettings
+		payload["properties"] = properties
+	}
+
+	req, err = autorest.Prepare(req, autorest.WithJSON(payload))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.AlertRulesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, fmt.Errorf("creating NRT Alert Rule: %+v", err)
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.AlertRulesClient", "CreateOrUpdate", resp, "Failure sending request")
+		return result, fmt.Errorf("creating NRT Alert Rule: %+v", err)
+	}
+
+	result, err = client.CreateOrUpdateResponder(resp)
+	if err != nil {
+		return result, fmt.Errorf("creating NRT Alert Rule: %+v", err)
+	}
+
+	return result, nil
+}
+
+func GetNrtAlertRule(ctx context.Context, client *securityinsight.AlertRulesClient, resourceGroupName, workspaceName, ruleID string) (result NrtAlertRuleModel, err error) {
+	// NOTE: as above, client.Get() unmarshals the NRT Alert Rule without the Event Grouping Settings - so these are
+	// unmarshalled from the response body separately
+	req, err := client.GetPreparer(ctx, resourceGroupName, workspaceName, ruleID)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.AlertRulesClient", "Get", nil, "Failure preparing request")
+		return result, fmt.Errorf("retrieving NRT Alert Rule: %+v", err)
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.AlertRulesClient", "Get", resp, "Failure sending request")
+		return result, fmt.Errorf("retrieving NRT Alert Rule: %+v", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, fmt.Errorf("reading NRT Alert Rule response: %+v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	result.AlertRuleModel, err = client.GetResponder(resp)
+	if err != nil {
+		return result, fmt.Errorf("retrieving NRT Alert Rule: %+v", err)
+	}
+
+	var rule struct {
+		Properties *struct {
+			EventGroupingSettings *securityinsight.EventGroupingSettings `json:"eventGroupingSettings"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &rule); err != nil {
+		return result, fmt.Errorf("unmarshalling NRT Alert Rule: %+v", err)
+	}
+	if rule.Properties != nil {
+		result.EventGroupingSettings = rule.Properties.EventGroupingSettings
+	}
+
+	return result, nil
+}
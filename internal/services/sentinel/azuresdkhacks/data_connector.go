@@ -0,0 +1,95 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// DataConnectorKindIoT is the kind of the IoT (Microsoft Defender for IoT) Data Connector, which isn't present in the SDK
+const DataConnectorKindIoT securityinsight.DataConnectorKind = "IOT"
+
+// IoTDataConnector is the IoT Data Connector, which isn't present in the SDK - as such the SDK unmarshals it
+// into a securityinsight.DataConnector (which doesn't contain the properties) instead
+type IoTDataConnector struct {
+	autorest.Response `json:"-"`
+
+	ID   *string                           `json:"id,omitempty"`
+	Name *string                           `json:"name,omitempty"`
+	Etag *string                           `json:"etag,omitempty"`
+	Kind securityinsight.DataConnectorKind `json:"kind,omitempty"`
+
+	*IoTDataConnectorProperties `json:"properties,omitempty"`
+}
+
+type IoTDataConnectorProperties struct {
+	SubscriptionID *string                                        `json:"subscriptionId,omitempty"`
+	DataTypes      *securityinsight.AlertsDataTypeOfDataConnector `json:"dataTypes,omitempty"`
+}
+
+func CreateOrUpdateIoTDataConnector(ctx context.Context, client *securityinsight.DataConnectorsClient, resourceGroupName, workspaceName, dataConnectorID string, input IoTDataConnector) (result IoTDataConnector, err error) {
+	// NOTE: client.CreateOrUpdate() requires a securityinsight.BasicDataConnector, which always marshals the SDK's own
+	// kind - so the request is prepared using an empty Data Connector and the body is then replaced with the IoT Data Connector
+	req, err := client.CreateOrUpdatePreparer(ctx, resourceGroupName, workspaceName, dataConnectorID, securityinsight.DataConnector{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.DataConnectorsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, fmt.Errorf("creating IoT Data Connector: %+v", err)
+	}
+
+	input.Kind = DataConnectorKindIoT
+	req, err = autorest.Prepare(req, autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.DataConnectorsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return result, fmt.Errorf("creating IoT Data Connector: %+v", err)
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.DataConnectorsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return result, fmt.Errorf("creating IoT Data Connector: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("creating IoT Data Connector: %+v", err)
+	}
+
+	return result, nil
+}
+
+func GetIoTDataConnector(ctx context.Context, client *securityinsight.DataConnectorsClient, resourceGroupName, workspaceName, dataConnectorID string) (result IoTDataConnector, err error) {
+	// NOTE: as above, client.Get() unmarshals the IoT Data Connector into a securityinsight.DataConnector without the properties
+	req, err := client.GetPreparer(ctx, resourceGroupName, workspaceName, dataConnectorID)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.DataConnectorsClient", "Get", nil, "Failure preparing request")
+		return result, fmt.Errorf("retrieving IoT Data Connector: %+v", err)
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.DataConnectorsClient", "Get", resp, "Failure sending request")
+		return result, fmt.Errorf("retrieving IoT Data Connector: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("retrieving IoT Data Connector: %+v", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ThreatIntelligenceIndicatorModel is securityinsight.ThreatIntelligenceIndicatorModel including the
+// ID and Name of the Indicator, which aren't present in the SDK model
+type ThreatIntelligenceIndicatorModel struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Etag *string `json:"etag,omitempty"`
+	Kind *string `json:"kind,omitempty"`
+
+	*securityinsight.ThreatIntelligenceIndicatorProperties `json:"properties,omitempty"`
+}
+
+type threatIntelligenceIndicatorList struct {
+	NextLink *string                            `json:"nextLink,omitempty"`
+	Value    []ThreatIntelligenceIndicatorModel `json:"value,omitempty"`
+}
+
+func CreateThreatIntelligenceIndicator(ctx context.Context, client *securityinsight.ThreatIntelligenceIndicatorClient, resourceGroupName, workspaceName string, input securityinsight.ThreatIntelligenceIndicatorModelForRequestBody) (result ThreatIntelligenceIndicatorModel, err error) {
+	// NOTE: the name of the Indicator is generated by the API, however the SDK model returned from
+	// client.CreateIndicator() doesn't contain the ID/Name so the response is unmarshalled here instead
+	req, err := client.CreateIndicatorPreparer(ctx, resourceGroupName, workspaceName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "CreateIndicator", nil, "Failure preparing request")
+		return result, fmt.Errorf("creating Threat Intelligence Indicator: %+v", err)
+	}
+	resp, err := client.CreateIndicatorSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "CreateIndicator", resp, "Failure sending request")
+		return result, fmt.Errorf("creating Threat Intelligence Indicator: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return result, fmt.Errorf("creating Threat Intelligence Indicator: %+v", err)
+	}
+
+	return result, nil
+}
+
+func QueryThreatIntelligenceIndicators(ctx context.Context, client *securityinsight.ThreatIntelligenceIndicatorClient, resourceGroupName, workspaceName string, criteria securityinsight.ThreatIntelligenceFilteringCriteria) ([]ThreatIntelligenceIndicatorModel, error) {
+	// NOTE: as above, the SDK models returned from client.QueryIndicators() don't contain the ID/Name of each Indicator
+	req, err := client.QueryIndicatorsPreparer(ctx, resourceGroupName, workspaceName, criteria)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "QueryIndicators", nil, "Failure preparing request")
+		return nil, fmt.Errorf("querying Threat Intelligence Indicators: %+v", err)
+	}
+
+	results := make([]ThreatIntelligenceIndicatorModel, 0)
+	for req != nil {
+		resp, err := client.QueryIndicatorsSender(req)
+		if err != nil {
+			err = autorest.NewErrorWithError(err, "securityinsight.ThreatIntelligenceIndicatorClient", "QueryIndicators", resp, "Failure sending request")
+			return nil, fmt.Errorf("querying Threat Intelligence Indicators: %+v", err)
+		}
+
+		var page threatIntelligenceIndicatorList
+		err = autorest.Respond(
+			resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, fmt.Errorf("querying Threat Intelligence Indicators: %+v", err)
+		}
+		results = append(results, page.Value...)
+
+		req = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+				autorest.AsJSON(),
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextLink))
+			if err != nil {
+				return nil, fmt.Errorf("preparing the next page of Threat Intelligence Indicators: %+v", err)
+			}
+		}
+	}
+
+	return results, nil
+}
@@ -10,6 +10,7 @@ type Client struct {
 	AlertRuleTemplatesClient *securityinsight.AlertRuleTemplatesClient
 	AutomationRulesClient    *securityinsight.AutomationRulesClient
 	DataConnectorsClient     *securityinsight.DataConnectorsClient
+	ThreatIntelligenceClient *securityinsight.ThreatIntelligenceIndicatorClient
 	WatchlistsClient         *securityinsight.WatchlistsClient
 	WatchlistItemsClient     *securityinsight.WatchlistItemsClient
 }
@@ -27,6 +28,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
+	threatIntelligenceClient := securityinsight.NewThreatIntelligenceIndicatorClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&threatIntelligenceClient.Client, o.ResourceManagerAuthorizer)
+
 	watchListsClient := securityinsight.NewWatchlistsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&watchListsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -38,6 +42,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
 		AutomationRulesClient:    &automationRulesClient,
 		DataConnectorsClient:     &dataConnectorsClient,
+		ThreatIntelligenceClient: &threatIntelligenceClient,
 		WatchlistsClient:         &watchListsClient,
 		WatchlistItemsClient:     &watchListItemsClient,
 	}
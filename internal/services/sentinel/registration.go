@@ -39,21 +39,32 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_sentinel_alert_rule_fusion":                                            resourceSentinelAlertRuleFusion(),
 		"azurerm_sentinel_alert_rule_machine_learning_behavior_analytics":               resourceSentinelAlertRuleMLBehaviorAnalytics(),
 		"azurerm_sentinel_alert_rule_ms_security_incident":                              resourceSentinelAlertRuleMsSecurityIncident(),
+		"azurerm_sentinel_alert_rule_nrt":                                               resourceSentinelAlertRuleNrt(),
 		"azurerm_sentinel_alert_rule_scheduled":                                         resourceSentinelAlertRuleScheduled(),
 		"azurerm_sentinel_data_connector_aws_cloud_trail":                               resourceSentinelDataConnectorAwsCloudTrail(),
 		"azurerm_sentinel_data_connector_azure_active_directory":                        resourceSentinelDataConnectorAzureActiveDirectory(),
 		"azurerm_sentinel_data_connector_azure_advanced_threat_protection":              resourceSentinelDataConnectorAzureAdvancedThreatProtection(),
 		"azurerm_sentinel_data_connector_azure_security_center":                         resourceSentinelDataConnectorAzureSecurityCenter(),
+		"azurerm_sentinel_data_connector_dynamics_365":                                  resourceSentinelDataConnectorDynamics365(),
+		"azurerm_sentinel_data_connector_iot":                                           resourceSentinelDataConnectorIoT(),
 		"azurerm_sentinel_data_connector_microsoft_cloud_app_security":                  resourceSentinelDataConnectorMicrosoftCloudAppSecurity(),
 		"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection": resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtection(),
+		"azurerm_sentinel_data_connector_microsoft_threat_intelligence":                 resourceSentinelDataConnectorMicrosoftThreatIntelligence(),
+		"azurerm_sentinel_data_connector_microsoft_threat_protection":                   resourceSentinelDataConnectorMicrosoftThreatProtection(),
 		"azurerm_sentinel_data_connector_office_365":                                    resourceSentinelDataConnectorOffice365(),
+		"azurerm_sentinel_data_connector_office_atp":                                    resourceSentinelDataConnectorOfficeATP(),
+		"azurerm_sentinel_data_connector_office_irm":                                    resourceSentinelDataConnectorOfficeIRM(),
 		"azurerm_sentinel_data_connector_threat_intelligence":                           resourceSentinelDataConnectorThreatIntelligence(),
+		"azurerm_sentinel_data_connector_threat_intelligence_taxii":                     resourceSentinelDataConnectorThreatIntelligenceTaxii(),
 		"azurerm_sentinel_automation_rule":                                              resourceSentinelAutomationRule(),
 	}
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		AlertRuleDefinitionDataSource{},
+		ThreatIntelligenceIndicatorsDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
@@ -61,5 +72,6 @@ func (r Registration) Resources() []sdk.Resource {
 		WatchlistResource{},
 		WatchlistItemResource{},
 		DataConnectorAwsS3Resource{},
+		ThreatIntelligenceIndicatorResource{},
 	}
 }
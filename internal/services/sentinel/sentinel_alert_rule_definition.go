@@ -0,0 +1,380 @@
+package sentinel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"gopkg.in/yaml.v3"
+)
+
+// alertRuleDefinition is an Analytics Rule in the YAML format used by the Azure Sentinel community repository
+type alertRuleDefinition struct {
+	Id                  string   `yaml:"id"`
+	Name                string   `yaml:"name"`
+	Description         string   `yaml:"description"`
+	Kind                string   `yaml:"kind"`
+	Version             string   `yaml:"version"`
+	Severity            string   `yaml:"severity"`
+	Query               string   `yaml:"query"`
+	QueryFrequency      string   `yaml:"queryFrequency"`
+	QueryPeriod         string   `yaml:"queryPeriod"`
+	TriggerOperator     string   `yaml:"triggerOperator"`
+	TriggerThreshold    int64    `yaml:"triggerThreshold"`
+	SuppressionEnabled  bool     `yaml:"suppressionEnabled"`
+	SuppressionDuration string   `yaml:"suppressionDuration"`
+	Tactics             []string `yaml:"tactics"`
+
+	EntityMappings []struct {
+		EntityType    string `yaml:"entityType"`
+		FieldMappings []struct {
+			Identifier string `yaml:"identifier"`
+			ColumnName string `yaml:"columnName"`
+		} `yaml:"fieldMappings"`
+	} `yaml:"entityMappings"`
+
+	CustomDetails map[string]string `yaml:"customDetails"`
+
+	AlertDetailsOverride *struct {
+		AlertDisplayNameFormat  string `yaml:"alertDisplayNameFormat"`
+		AlertDescriptionFormat  string `yaml:"alertDescriptionFormat"`
+		AlertTacticsColumnName  string `yaml:"alertTacticsColumnName"`
+		AlertSeverityColumnName string `yaml:"alertSeverityColumnName"`
+	} `yaml:"alertDetailsOverride"`
+
+	EventGroupingSettings *struct {
+		AggregationKind string `yaml:"aggregationKind"`
+	} `yaml:"eventGroupingSettings"`
+
+	IncidentConfiguration *struct {
+		CreateIncident        bool `yaml:"createIncident"`
+		GroupingConfiguration *struct {
+			Enabled              bool     `yaml:"enabled"`
+			ReopenClosedIncident bool     `yaml:"reopenClosedIncident"`
+			LookbackDuration     string   `yaml:"lookbackDuration"`
+			MatchingMethod       string   `yaml:"matchingMethod"`
+			GroupByEntities      []string `yaml:"groupByEntities"`
+			GroupByAlertDetails  []string `yaml:"groupByAlertDetails"`
+			GroupByCustomDetails []string `yaml:"groupByCustomDetails"`
+		} `yaml:"groupingConfiguration"`
+	} `yaml:"incidentConfiguration"`
+}
+
+var alertRuleDefinitionDurationRegex = regexp.MustCompile(`^(\d+)([smhdSMHD])$`)
+
+// convertAlertRuleDefinitionDuration converts a duration in the format used by the Sentinel community repository
+// (e.g. `5m`, `1h` or `14d`) into an ISO 8601 duration (e.g. `PT5M`, `PT1H` or `P14D`)
+func convertAlertRuleDefinitionDuration(input string) (string, error) {
+	if strings.HasPrefix(strings.ToUpper(input), "P") {
+		return strings.ToUpper(input), nil
+	}
+
+	matches := alertRuleDefinitionDurationRegex.FindStringSubmatch(input)
+	if matches == nil {
+		return "", fmt.Errorf("expected a duration such as `5m`, `1h` or `14d` but got %q", input)
+	}
+
+	switch strings.ToLower(matches[2]) {
+	case "d":
+		return fmt.Sprintf("P%sD", matches[1]), nil
+	case "h":
+		return fmt.Sprintf("PT%sH", matches[1]), nil
+	case "m":
+		return fmt.Sprintf("PT%sM", matches[1]), nil
+	default:
+		return fmt.Sprintf("PT%sS", matches[1]), nil
+	}
+}
+
+// convertAlertRuleDefinitionTriggerOperator converts the short form of the trigger operator (e.g. `gt`) used by the
+// Sentinel community repository into the value used by the API (e.g. `GreaterThan`)
+func convertAlertRuleDefinitionTriggerOperator(input string) string {
+	operators := map[string]securityinsight.TriggerOperator{
+		"gt": securityinsight.TriggerOperatorGreaterThan,
+		"lt": securityinsight.TriggerOperatorLessThan,
+		"eq": securityinsight.TriggerOperatorEqual,
+		"ne": securityinsight.TriggerOperatorNotEqual,
+	}
+	if v, ok := operators[strings.ToLower(input)]; ok {
+		return string(v)
+	}
+
+	for _, v := range securityinsight.PossibleTriggerOperatorValues() {
+		if strings.EqualFold(input, string(v)) {
+			return string(v)
+		}
+	}
+
+	return input
+}
+
+// trimAlertRuleDefinitionText trims the whitespace and the single quotes which the Sentinel community repository
+// conventionally wraps around block scalars (such as the description)
+func trimAlertRuleDefinitionText(input string) string {
+	output := strings.TrimSpace(input)
+	if len(output) >= 2 && strings.HasPrefix(output, "'") && strings.HasSuffix(output, "'") {
+		output = strings.TrimSpace(output[1 : len(output)-1])
+	}
+	return output
+}
+
+func parseAlertRuleDefinition(content string) (*AlertRuleDefinitionDataSourceModel, error) {
+	var definition alertRuleDefinition
+	if err := yaml.Unmarshal([]byte(content), &definition); err != nil {
+		return nil, fmt.Errorf("unmarshaling YAML: %+v", err)
+	}
+
+	kind := definition.Kind
+	if kind == "" {
+		kind = string(securityinsight.AlertRuleKindScheduled)
+	}
+	if !strings.EqualFold(kind, string(securityinsight.AlertRuleKindScheduled)) && !strings.EqualFold(kind, string(securityinsight.AlertRuleKindNRT)) {
+		return nil, fmt.Errorf("the kind %q isn't supported, only %q and %q Alert Rules are supported", kind, securityinsight.AlertRuleKindScheduled, securityinsight.AlertRuleKindNRT)
+	}
+
+	// where values aren't specified within the definition the defaults from `azurerm_sentinel_alert_rule_scheduled`
+	// are used, so that the values can be passed through as-is
+	model := AlertRuleDefinitionDataSourceModel{
+		Content:                  content,
+		Kind:                     kind,
+		AlertRuleTemplateGuid:    definition.Id,
+		AlertRuleTemplateVersion: definition.Version,
+		DisplayName:              trimAlertRuleDefinitionText(definition.Name),
+		Description:              trimAlertRuleDefinitionText(definition.Description),
+		Severity:                 definition.Severity,
+		Query:                    strings.TrimSpace(definition.Query),
+		QueryFrequency:           "PT5H",
+		QueryPeriod:              "PT5H",
+		TriggerOperator:          string(securityinsight.TriggerOperatorGreaterThan),
+		TriggerThreshold:         definition.TriggerThreshold,
+		SuppressionEnabled:       definition.SuppressionEnabled,
+		SuppressionDuration:      "PT5H",
+		Tactics:                  make([]string, 0),
+		EventGrouping:            make([]AlertRuleDefinitionEventGrouping, 0),
+		IncidentConfiguration:    make([]AlertRuleDefinitionIncidentConfiguration, 0),
+		AlertDetailsOverride:     make([]AlertRuleDefinitionAlertDetailsOverride, 0),
+		CustomDetails:            make(map[string]string),
+		EntityMapping:            make([]AlertRuleDefinitionEntityMapping, 0),
+	}
+
+	durations := map[string]*string{
+		"query_frequency":      &model.QueryFrequency,
+		"query_period":         &model.QueryPeriod,
+		"suppression_duration": &model.SuppressionDuration,
+	}
+	for key, input := range map[string]string{
+		"query_frequency":      definition.QueryFrequency,
+		"query_period":         definition.QueryPeriod,
+		"suppression_duration": definition.SuppressionDuration,
+	} {
+		if input == "" {
+			continue
+		}
+		v, err := convertAlertRuleDefinitionDuration(input)
+		if err != nil {
+			return nil, fmt.Errorf("converting `%s`: %+v", key, err)
+		}
+		*durations[key] = v
+	}
+
+	if definition.TriggerOperator != "" {
+		model.TriggerOperator = convertAlertRuleDefinitionTriggerOperator(definition.TriggerOperator)
+	}
+	if definition.Tactics != nil {
+		model.Tactics = definition.Tactics
+	}
+	for k, v := range definition.CustomDetails {
+		model.CustomDetails[k] = v
+	}
+
+	if v := definition.EventGroupingSettings; v != nil {
+		model.EventGrouping = append(model.EventGrouping, AlertRuleDefinitionEventGrouping{
+			AggregationMethod: v.AggregationKind,
+		})
+	}
+
+	if v := definition.AlertDetailsOverride; v != nil {
+		model.AlertDetailsOverride = append(model.AlertDetailsOverride, AlertRuleDefinitionAlertDetailsOverride{
+			DescriptionFormat:  v.AlertDescriptionFormat,
+			DisplayNameFormat:  v.AlertDisplayNameFormat,
+			SeverityColumnName: v.AlertSeverityColumnName,
+			TacticsColumnName:  v.AlertTacticsColumnName,
+		})
+	}
+
+	if v := definition.IncidentConfiguration; v != nil {
+		incidentConfiguration := AlertRuleDefinitionIncidentConfiguration{
+			CreateIncident: v.CreateIncident,
+			Grouping:       make([]AlertRuleDefinitionIncidentGroup, 0),
+		}
+
+		if g := v.GroupingConfiguration; g != nil {
+			grouping := AlertRuleDefinitionIncidentGroup{
+				Enabled:               g.Enabled,
+				LookbackDuration:      "PT5M",
+				ReopenClosedIncidents: g.ReopenClosedIncident,
+				EntityMatchingMethod:  string(securityinsight.MatchingMethodAnyAlert),
+				GroupByEntities:       make([]string, 0),
+				GroupByAlertDetails:   make([]string, 0),
+				GroupByCustomDetails:  make([]string, 0),
+			}
+			if g.LookbackDuration != "" {
+				lookbackDuration, err := convertAlertRuleDefinitionDuration(g.LookbackDuration)
+				if err != nil {
+					return nil, fmt.Errorf("converting `lookback_duration`: %+v", err)
+				}
+				grouping.LookbackDuration = lookbackDuration
+			}
+			if g.MatchingMethod != "" {
+				grouping.EntityMatchingMethod = g.MatchingMethod
+			}
+			grouping.GroupByEntities = append(grouping.GroupByEntities, g.GroupByEntities...)
+			grouping.GroupByAlertDetails = append(grouping.GroupByAlertDetails, g.GroupByAlertDetails...)
+			grouping.GroupByCustomDetails = append(grouping.GroupByCustomDetails, g.GroupByCustomDetails...)
+
+			incidentConfiguration.Grouping = append(incidentConfiguration.Grouping, grouping)
+		}
+
+		model.IncidentConfiguration = append(model.IncidentConfiguration, incidentConfiguration)
+	}
+
+	for _, v := range definition.EntityMappings {
+		entityMapping := AlertRuleDefinitionEntityMapping{
+			EntityType:   v.EntityType,
+			FieldMapping: make([]AlertRuleDefinitionFieldMapping, 0),
+		}
+		for _, f := range v.FieldMappings {
+			entityMapping.FieldMapping = append(entityMapping.FieldMapping, AlertRuleDefinitionFieldMapping{
+				Identifier: f.Identifier,
+				ColumnName: f.ColumnName,
+			})
+		}
+		model.EntityMapping = append(model.EntityMapping, entityMapping)
+	}
+
+	if err := validateAlertRuleDefinition(model); err != nil {
+		return nil, err
+	}
+
+	return &model, nil
+}
+
+// validateAlertRuleDefinition validates the normalized values using the schema of `azurerm_sentinel_alert_rule_scheduled`,
+// so that the definition is validated against the same values (e.g. tactics and entity types) as the resource
+func validateAlertRuleDefinition(model AlertRuleDefinitionDataSourceModel) error {
+	s := resourceSentinelAlertRuleScheduled().Schema
+
+	if model.DisplayName == "" {
+		return fmt.Errorf("`name` is required")
+	}
+	if model.Query == "" {
+		return fmt.Errorf("`query` is required")
+	}
+
+	values := map[string]interface{}{
+		"alert_rule_template_version": model.AlertRuleTemplateVersion,
+		"severity":                    model.Severity,
+		"query_frequency":             model.QueryFrequency,
+		"query_period":                model.QueryPeriod,
+		"trigger_operator":            model.TriggerOperator,
+		"trigger_threshold":           int(model.TriggerThreshold),
+		"suppression_duration":        model.SuppressionDuration,
+	}
+	if model.AlertRuleTemplateGuid != "" {
+		values["alert_rule_template_guid"] = model.AlertRuleTemplateGuid
+	}
+	for key, value := range values {
+		if err := validateAlertRuleDefinitionValue(s, []string{key}, value); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range model.Tactics {
+		if err := validateAlertRuleDefinitionValue(s, []string{"tactics"}, v); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range model.EventGrouping {
+		if err := validateAlertRuleDefinitionValue(s, []string{"event_grouping", "aggregation_method"}, v.AggregationMethod); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range model.IncidentConfiguration {
+		for _, g := range v.Grouping {
+			path := []string{"incident_configuration", "grouping"}
+			if err := validateAlertRuleDefinitionValue(s, append(path, "lookback_duration"), g.LookbackDuration); err != nil {
+				return err
+			}
+			if err := validateAlertRuleDefinitionValue(s, append(path, "entity_matching_method"), g.EntityMatchingMethod); err != nil {
+				return err
+			}
+			for _, e := range g.GroupByEntities {
+				if err := validateAlertRuleDefinitionValue(s, append(path, "group_by_entities"), e); err != nil {
+					return err
+				}
+			}
+			for _, a := range g.GroupByAlertDetails {
+				if err := validateAlertRuleDefinitionValue(s, append(path, "group_by_alert_details"), a); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if max := s["entity_mapping"].MaxItems; len(model.EntityMapping) > max {
+		return fmt.Errorf("expected at most %d `entity_mapping` but got %d", max, len(model.EntityMapping))
+	}
+	for _, v := range model.EntityMapping {
+		if err := validateAlertRuleDefinitionValue(s, []string{"entity_mapping", "entity_type"}, v.EntityType); err != nil {
+			return err
+		}
+
+		fieldMappingSchema := s["entity_mapping"].Elem.(*pluginsdk.Resource).Schema["field_mapping"]
+		if len(v.FieldMapping) == 0 || len(v.FieldMapping) > fieldMappingSchema.MaxItems {
+			return fmt.Errorf("expected between 1 and %d `field_mapping` for the entity type %q but got %d", fieldMappingSchema.MaxItems, v.EntityType, len(v.FieldMapping))
+		}
+		for _, f := range v.FieldMapping {
+			if err := validateAlertRuleDefinitionValue(s, []string{"entity_mapping", "field_mapping", "identifier"}, f.Identifier); err != nil {
+				return err
+			}
+			if err := validateAlertRuleDefinitionValue(s, []string{"entity_mapping", "field_mapping", "column_name"}, f.ColumnName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAlertRuleDefinitionValue runs the ValidateFunc of the field at the specified path within the schema
+func validateAlertRuleDefinitionValue(s map[string]*pluginsdk.Schema, path []string, value interface{}) error {
+	var field *pluginsdk.Schema
+	for i, key := range path {
+		field = s[key]
+		if field == nil {
+			return fmt.Errorf("internal-error: the field %q was not found in the schema", strings.Join(path[:i+1], "."))
+		}
+		if resource, ok := field.Elem.(*pluginsdk.Resource); ok {
+			s = resource.Schema
+		}
+	}
+
+	validateFunc := field.ValidateFunc
+	if elem, ok := field.Elem.(*pluginsdk.Schema); ok {
+		validateFunc = elem.ValidateFunc
+	}
+	if validateFunc == nil {
+		return nil
+	}
+
+	key := strings.Join(path, ".")
+	if _, errs := validateFunc(value, key); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
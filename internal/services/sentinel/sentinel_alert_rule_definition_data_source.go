@@ -0,0 +1,228 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AlertRuleDefinitionDataSource struct{}
+
+var _ sdk.DataSource = AlertRuleDefinitionDataSource{}
+
+type AlertRuleDefinitionDataSourceModel struct {
+	Content                  string                                     `tfschema:"content"`
+	Kind                     string                                     `tfschema:"kind"`
+	AlertRuleTemplateGuid    string                                     `tfschema:"alert_rule_template_guid"`
+	AlertRuleTemplateVersion string                                     `tfschema:"alert_rule_template_version"`
+	DisplayName              string                                     `tfschema:"display_name"`
+	Description              string                                     `tfschema:"description"`
+	Severity                 string                                     `tfschema:"severity"`
+	Query                    string                                     `tfschema:"query"`
+	QueryFrequency           string                                     `tfschema:"query_frequency"`
+	QueryPeriod              string                                     `tfschema:"query_period"`
+	TriggerOperator          string                                     `tfschema:"trigger_operator"`
+	TriggerThreshold         int64                                      `tfschema:"trigger_threshold"`
+	SuppressionEnabled       bool                                       `tfschema:"suppression_enabled"`
+	SuppressionDuration      string                                     `tfschema:"suppression_duration"`
+	Tactics                  []string                                   `tfschema:"tactics"`
+	EventGrouping            []AlertRuleDefinitionEventGrouping         `tfschema:"event_grouping"`
+	IncidentConfiguration    []AlertRuleDefinitionIncidentConfiguration `tfschema:"incident_configuration"`
+	AlertDetailsOverride     []AlertRuleDefinitionAlertDetailsOverride  `tfschema:"alert_details_override"`
+	CustomDetails            map[string]string                          `tfschema:"custom_details"`
+	EntityMapping            []AlertRuleDefinitionEntityMapping         `tfschema:"entity_mapping"`
+}
+
+type AlertRuleDefinitionEventGrouping struct {
+	AggregationMethod string `tfschema:"aggregation_method"`
+}
+
+type AlertRuleDefinitionIncidentConfiguration struct {
+	CreateIncident bool                               `tfschema:"create_incident"`
+	Grouping       []AlertRuleDefinitionIncidentGroup `tfschema:"grouping"`
+}
+
+type AlertRuleDefinitionIncidentGroup struct {
+	Enabled               bool     `tfschema:"enabled"`
+	LookbackDuration      string   `tfschema:"lookback_duration"`
+	ReopenClosedIncidents bool     `tfschema:"reopen_closed_incidents"`
+	EntityMatchingMethod  string   `tfschema:"entity_matching_method"`
+	GroupByEntities       []string `tfschema:"group_by_entities"`
+	GroupByAlertDetails   []string `tfschema:"group_by_alert_details"`
+	GroupByCustomDetails  []string `tfschema:"group_by_custom_details"`
+}
+
+type AlertRuleDefinitionAlertDetailsOverride struct {
+	DescriptionFormat  string `tfschema:"description_format"`
+	DisplayNameFormat  string `tfschema:"display_name_format"`
+	SeverityColumnName string `tfschema:"severity_column_name"`
+	TacticsColumnName  string `tfschema:"tactics_column_name"`
+}
+
+type AlertRuleDefinitionEntityMapping struct {
+	EntityType   string                            `tfschema:"entity_type"`
+	FieldMapping []AlertRuleDefinitionFieldMapping `tfschema:"field_mapping"`
+}
+
+type AlertRuleDefinitionFieldMapping struct {
+	Identifier string `tfschema:"identifier"`
+	ColumnName string `tfschema:"column_name"`
+}
+
+func (d AlertRuleDefinitionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d AlertRuleDefinitionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	computedString := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		}
+	}
+	computedBool := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		}
+	}
+	computedStringList := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"kind":                        computedString(),
+		"alert_rule_template_guid":    computedString(),
+		"alert_rule_template_version": computedString(),
+		"display_name":                computedString(),
+		"description":                 computedString(),
+		"severity":                    computedString(),
+		"query":                       computedString(),
+		"query_frequency":             computedString(),
+		"query_period":                computedString(),
+		"trigger_operator":            computedString(),
+		"trigger_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+		"suppression_enabled":  computedBool(),
+		"suppression_duration": computedString(),
+		"tactics":              computedStringList(),
+		"event_grouping": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"aggregation_method": computedString(),
+				},
+			},
+		},
+		"incident_configuration": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"create_incident": computedBool(),
+					"grouping": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"enabled":                 computedBool(),
+								"lookback_duration":       computedString(),
+								"reopen_closed_incidents": computedBool(),
+								"entity_matching_method":  computedString(),
+								"group_by_entities":       computedStringList(),
+								"group_by_alert_details":  computedStringList(),
+								"group_by_custom_details": computedStringList(),
+							},
+						},
+					},
+				},
+			},
+		},
+		"alert_details_override": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"description_format":   computedString(),
+					"display_name_format":  computedString(),
+					"severity_column_name": computedString(),
+					"tactics_column_name":  computedString(),
+				},
+			},
+		},
+		"custom_details": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"entity_mapping": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"entity_type": computedString(),
+					"field_mapping": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"identifier":  computedString(),
+								"column_name": computedString(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AlertRuleDefinitionDataSource) ModelObject() interface{} {
+	return &AlertRuleDefinitionDataSourceModel{}
+}
+
+func (d AlertRuleDefinitionDataSource) ResourceType() string {
+	return "azurerm_sentinel_alert_rule_definition"
+}
+
+func (d AlertRuleDefinitionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config AlertRuleDefinitionDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			model, err := parseAlertRuleDefinition(config.Content)
+			if err != nil {
+				return fmt.Errorf("parsing the Sentinel Alert Rule definition: %+v", err)
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(config.Content))))
+			return metadata.Encode(model)
+		},
+	}
+}
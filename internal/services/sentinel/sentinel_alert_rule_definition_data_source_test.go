@@ -0,0 +1,124 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SentinelAlertRuleDefinitionDataSource struct{}
+
+func TestAccSentinelAlertRuleDefinitionDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_alert_rule_definition", "test")
+	r := SentinelAlertRuleDefinitionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kind").HasValue("Scheduled"),
+				check.That(data.ResourceName).Key("display_name").HasValue("Rare RDP Connections"),
+				check.That(data.ResourceName).Key("query_frequency").HasValue("P1D"),
+				check.That(data.ResourceName).Key("query_period").HasValue("P14D"),
+				check.That(data.ResourceName).Key("trigger_operator").HasValue("GreaterThan"),
+				check.That(data.ResourceName).Key("tactics.#").HasValue("1"),
+				check.That(data.ResourceName).Key("entity_mapping.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleDefinitionDataSource_scheduledAlertRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleDefinitionDataSource{}
+
+	data.ResourceTest(t, SentinelAlertRuleScheduledResource{}, []acceptance.TestStep{
+		{
+			Config: r.scheduledAlertRule(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(SentinelAlertRuleScheduledResource{}),
+				check.That(data.ResourceName).Key("query_frequency").HasValue("P1D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SentinelAlertRuleDefinitionDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+`, r.definition())
+}
+
+func (SentinelAlertRuleDefinitionDataSource) definition() string {
+	return `
+data "azurerm_sentinel_alert_rule_definition" "test" {
+  content = <<YAML
+id: 0b9ae89d-8cad-461c-808f-0494f70ad5c4
+name: Rare RDP Connections
+description: |
+  'Identifies when an RDP connection is new or rare related to any logon type in a given workspace.'
+severity: Medium
+queryFrequency: 1d
+queryPeriod: 14d
+triggerOperator: gt
+triggerThreshold: 0
+tactics:
+  - LateralMovement
+query: |
+  SecurityEvent
+  | where EventID == 4624 and LogonType == 10
+entityMappings:
+  - entityType: IP
+    fieldMappings:
+      - identifier: Address
+        columnName: IpAddress
+version: 1.0.1
+kind: Scheduled
+YAML
+}
+`
+}
+
+func (r SentinelAlertRuleDefinitionDataSource) scheduledAlertRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = data.azurerm_sentinel_alert_rule_definition.test.display_name
+  description                = data.azurerm_sentinel_alert_rule_definition.test.description
+  severity                   = data.azurerm_sentinel_alert_rule_definition.test.severity
+  query                      = data.azurerm_sentinel_alert_rule_definition.test.query
+  query_frequency            = data.azurerm_sentinel_alert_rule_definition.test.query_frequency
+  query_period               = data.azurerm_sentinel_alert_rule_definition.test.query_period
+  trigger_operator           = data.azurerm_sentinel_alert_rule_definition.test.trigger_operator
+  trigger_threshold          = data.azurerm_sentinel_alert_rule_definition.test.trigger_threshold
+  tactics                    = data.azurerm_sentinel_alert_rule_definition.test.tactics
+
+  dynamic "entity_mapping" {
+    for_each = data.azurerm_sentinel_alert_rule_definition.test.entity_mapping
+    content {
+      entity_type = entity_mapping.value.entity_type
+
+      dynamic "field_mapping" {
+        for_each = entity_mapping.value.field_mapping
+        content {
+          identifier  = field_mapping.value.identifier
+          column_name = field_mapping.value.column_name
+        }
+      }
+    }
+  }
+}
+`, SentinelAlertRuleScheduledResource{}.template(data), r.definition(), data.RandomInteger)
+}
@@ -0,0 +1,206 @@
+package sentinel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConvertAlertRuleDefinitionDuration(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{Input: "30s", Expected: "PT30S"},
+		{Input: "5m", Expected: "PT5M"},
+		{Input: "1h", Expected: "PT1H"},
+		{Input: "14d", Expected: "P14D"},
+		{Input: "1H", Expected: "PT1H"},
+		{Input: "PT5H", Expected: "PT5H"},
+		{Input: "p1d", Expected: "P1D"},
+		{Input: "", Error: true},
+		{Input: "1w", Error: true},
+		{Input: "1.5h", Error: true},
+		{Input: "h", Error: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := convertAlertRuleDefinitionDuration(v.Input)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestConvertAlertRuleDefinitionTriggerOperator(t *testing.T) {
+	testData := map[string]string{
+		"gt":          "GreaterThan",
+		"LT":          "LessThan",
+		"eq":          "Equal",
+		"ne":          "NotEqual",
+		"greaterthan": "GreaterThan",
+		"Equal":       "Equal",
+		"bogus":       "bogus",
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		if actual := convertAlertRuleDefinitionTriggerOperator(input); actual != expected {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestParseAlertRuleDefinition(t *testing.T) {
+	content := `id: 0b9ae89d-8cad-461c-808f-0494f70ad5c4
+name: Rare RDP Connections
+description: |
+  'Identifies when an RDP connection is new or rare related to any logon type in a given workspace.'
+severity: Medium
+requiredDataConnectors:
+  - connectorId: SecurityEvents
+    dataTypes:
+      - SecurityEvent
+queryFrequency: 1d
+queryPeriod: 14d
+triggerOperator: gt
+triggerThreshold: 0
+tactics:
+  - LateralMovement
+relevantTechniques:
+  - T1021
+query: |
+  SecurityEvent
+  | where EventID == 4624 and LogonType == 10
+entityMappings:
+  - entityType: Account
+    fieldMappings:
+      - identifier: FullName
+        columnName: Account
+  - entityType: IP
+    fieldMappings:
+      - identifier: Address
+        columnName: IpAddress
+customDetails:
+  LogonType: LogonType
+alertDetailsOverride:
+  alertDisplayNameFormat: RDP connection from {{IpAddress}}
+eventGroupingSettings:
+  aggregationKind: AlertPerResult
+incidentConfiguration:
+  createIncident: true
+  groupingConfiguration:
+    enabled: true
+    reopenClosedIncident: false
+    lookbackDuration: 5h
+    matchingMethod: Selected
+    groupByEntities:
+      - Account
+version: 1.0.1
+kind: Scheduled
+`
+
+	actual, err := parseAlertRuleDefinition(content)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	expected := AlertRuleDefinitionDataSourceModel{
+		Content:                  content,
+		Kind:                     "Scheduled",
+		AlertRuleTemplateGuid:    "0b9ae89d-8cad-461c-808f-0494f70ad5c4",
+		AlertRuleTemplateVersion: "1.0.1",
+		DisplayName:              "Rare RDP Connections",
+		Description:              "Identifies when an RDP connection is new or rare related to any logon type in a given workspace.",
+		Severity:                 "Medium",
+		Query:                    "SecurityEvent\n| where EventID == 4624 and LogonType == 10",
+		QueryFrequency:           "P1D",
+		QueryPeriod:              "P14D",
+		TriggerOperator:          "GreaterThan",
+		TriggerThreshold:         0,
+		SuppressionEnabled:       false,
+		SuppressionDuration:      "PT5H",
+		Tactics:                  []string{"LateralMovement"},
+		EventGrouping: []AlertRuleDefinitionEventGrouping{
+			{AggregationMethod: "AlertPerResult"},
+		},
+		IncidentConfiguration: []AlertRuleDefinitionIncidentConfiguration{
+			{
+				CreateIncident: true,
+				Grouping: []AlertRuleDefinitionIncidentGroup{
+					{
+						Enabled:               true,
+						LookbackDuration:      "PT5H",
+						ReopenClosedIncidents: false,
+						EntityMatchingMethod:  "Selected",
+						GroupByEntities:       []string{"Account"},
+						GroupByAlertDetails:   []string{},
+						GroupByCustomDetails:  []string{},
+					},
+				},
+			},
+		},
+		AlertDetailsOverride: []AlertRuleDefinitionAlertDetailsOverride{
+			{DisplayNameFormat: "RDP connection from {{IpAddress}}"},
+		},
+		CustomDetails: map[string]string{
+			"LogonType": "LogonType",
+		},
+		EntityMapping: []AlertRuleDefinitionEntityMapping{
+			{
+				EntityType:   "Account",
+				FieldMapping: []AlertRuleDefinitionFieldMapping{{Identifier: "FullName", ColumnName: "Account"}},
+			},
+			{
+				EntityType:   "IP",
+				FieldMapping: []AlertRuleDefinitionFieldMapping{{Identifier: "Address", ColumnName: "IpAddress"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+}
+
+func TestParseAlertRuleDefinitionInvalid(t *testing.T) {
+	base := `name: Example
+query: SecurityEvent
+severity: High
+`
+	testData := map[string]string{
+		"Invalid YAML":               "name: [",
+		"Missing Name":               "query: SecurityEvent\nseverity: High\n",
+		"Missing Query":              "name: Example\nseverity: High\n",
+		"Unsupported Kind":           base + "kind: Fusion\n",
+		"Invalid Severity":           "name: Example\nquery: SecurityEvent\nseverity: Critical\n",
+		"Invalid Template ID":        base + "id: not-a-guid\n",
+		"Invalid Duration":           base + "queryFrequency: 1w\n",
+		"Duration Out Of Range":      base + "queryPeriod: 30d\n",
+		"Invalid Trigger Operator":   base + "triggerOperator: gte\n",
+		"Negative Trigger Threshold": base + "triggerThreshold: -1\n",
+		"Invalid Tactic":             base + "tactics:\n  - Teleportation\n",
+		"Invalid Entity Type":        base + "entityMappings:\n  - entityType: Spaceship\n    fieldMappings:\n      - identifier: Name\n        columnName: Name\n",
+		"Missing Field Mappings":     base + "entityMappings:\n  - entityType: Host\n",
+	}
+
+	for name, content := range testData {
+		t.Logf("[DEBUG] Testing %q", name)
+
+		if _, err := parseAlertRuleDefinition(content); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package sentinel
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSentinelAlertRuleNrtCreateUpdate,
+		Read:   resourceSentinelAlertRuleNrtRead,
+		Update: resourceSentinelAlertRuleNrtCreateUpdate,
+		Delete: resourceSentinelAlertRuleNrtDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.AlertRuleID(id)
+			return err
+		}, importSentinelAlertRule(securityinsight.AlertRuleKindNRT)),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"alert_rule_template_guid": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"alert_rule_template_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"event_grouping": alertRuleEventGroupingSchema(),
+
+			"tactics": alertRuleTacticsSchema(),
+
+			"incident_configuration": alertRuleIncidentConfigurationSchema(),
+
+			"severity": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(securityinsight.AlertSeverityHigh),
+					string(securityinsight.AlertSeverityMedium),
+					string(securityinsight.AlertSeverityLow),
+					string(securityinsight.AlertSeverityInformational),
+				}, false),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"query": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"suppression_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"suppression_duration": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "PT5H",
+				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "PT24H"),
+			},
+			"alert_details_override": alertRuleAlertDetailsOverrideSchema(),
+			"custom_details":         alertRuleCustomDetailsSchema(),
+			"entity_mapping":         alertRuleEntityMappingSchema(),
+		},
+	}
+}
+
+func resourceSentinelAlertRuleNrtCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	workspaceID, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewAlertRuleID(workspaceID.SubscriptionId, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name)
+
+	if d.IsNewResource() {
+		resp, err := client.Get(ctx, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("checking for existing Sentinel Alert Rule NRT %q: %+v", id, err)
+			}
+		}
+
+		id := alertRuleID(resp.Value)
+		if id != nil && *id != "" {
+			return tf.ImportAsExistsError("azurerm_sentinel_alert_rule_nrt", *id)
+		}
+	}
+
+	suppressionDuration := d.Get("suppression_duration").(string)
+	suppressionEnabled := d.Get("suppression_enabled").(bool)
+
+	param := securityinsight.NrtAlertRule{
+		Kind: securityinsight.KindBasicAlertRuleKindNRT,
+		NrtAlertRuleProperties: &securityinsight.NrtAlertRuleProperties{
+			Description:           utils.String(d.Get("description").(string)),
+			DisplayName:           utils.String(d.Get("display_name").(string)),
+			Tactics:               expandAlertRuleTactics(d.Get("tactics").(*pluginsdk.Set).List()),
+			IncidentConfiguration: expandAlertRuleIncidentConfiguration(d.Get("incident_configuration").([]interface{})),
+			Severity:              securityinsight.AlertSeverity(d.Get("severity").(string)),
+			Enabled:               utils.Bool(d.Get("enabled").(bool)),
+			Query:                 utils.String(d.Get("query").(string)),
+			SuppressionEnabled:    &suppressionEnabled,
+			SuppressionDuration:   &suppressionDuration,
+		},
+	}
+
+	if v, ok := d.GetOk("alert_rule_template_guid"); ok {
+		param.NrtAlertRuleProperties.AlertRuleTemplateName = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("alert_rule_template_version"); ok {
+		param.NrtAlertRuleProperties.TemplateVersion = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("alert_details_override"); ok {
+		param.NrtAlertRuleProperties.AlertDetailsOverride = expandAlertRuleAlertDetailsOverride(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("custom_details"); ok {
+		param.NrtAlertRuleProperties.CustomDetails = utils.ExpandMapStringPtrString(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("entity_mapping"); ok {
+		param.NrtAlertRuleProperties.EntityMappings = expandAlertRuleEntityMapping(v.([]interface{}))
+	}
+
+	if !d.IsNewResource() {
+		resp, err := client.Get(ctx, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Sentinel Alert Rule NRT %q: %+v", id, err)
+		}
+
+		if err := assertAlertRuleKind(resp.Value, securityinsight.AlertRuleKindNRT); err != nil {
+			return fmt.Errorf("asserting alert rule of %q: %+v", id, err)
+		}
+	}
+
+	eventGroupingSettings := expandAlertRuleEventGroupingSetting(d.Get("event_grouping").([]interface{}))
+	if _, err := azuresdkhacks.CreateOrUpdateNrtAlertRule(ctx, client, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name, param, eventGroupingSettings); err != nil {
+		return fmt.Errorf("creating Sentinel Alert Rule NRT %q: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSentinelAlertRuleNrtRead(d, meta)
+}
+
+func resourceSentinelAlertRuleNrtRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AlertRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := azuresdkhacks.GetNrtAlertRule(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Sentinel Alert Rule NRT %q was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Sentinel Alert Rule NRT %q: %+v", id, err)
+	}
+
+	if err := assertAlertRuleKind(resp.Value, securityinsight.AlertRuleKindNRT); err != nil {
+		return fmt.Errorf("asserting alert rule of %q: %+v", id, err)
+	}
+	rule := resp.Value.(securityinsight.NrtAlertRule)
+
+	d.Set("name", id.Name)
+
+	workspaceId := loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+	d.Set("log_analytics_workspace_id", workspaceId.ID())
+
+	if prop := rule.NrtAlertRuleProperties; prop != nil {
+		d.Set("description", prop.Description)
+		d.Set("display_name", prop.DisplayName)
+		if err := d.Set("tactics", flattenAlertRuleTactics(prop.Tactics)); err != nil {
+			return fmt.Errorf("setting `tactics`: %+v", err)
+		}
+		if err := d.Set("incident_configuration", flattenAlertRuleIncidentConfiguration(prop.IncidentConfiguration)); err != nil {
+			return fmt.Errorf("setting `incident_configuration`: %+v", err)
+		}
+		if err := d.Set("event_grouping", flattenAlertRuleEventGroupingSetting(resp.EventGroupingSettings)); err != nil {
+			return fmt.Errorf("setting `event_grouping`: %+v", err)
+		}
+		d.Set("severity", string(prop.Severity))
+		d.Set("enabled", prop.Enabled)
+		d.Set("query", prop.Query)
+		d.Set("suppression_enabled", prop.SuppressionEnabled)
+		d.Set("suppression_duration", prop.SuppressionDuration)
+		d.Set("alert_rule_template_guid", prop.AlertRuleTemplateName)
+		d.Set("alert_rule_template_version", prop.TemplateVersion)
+
+		if err := d.Set("alert_details_override", flattenAlertRuleAlertDetailsOverride(prop.AlertDetailsOverride)); err != nil {
+			return fmt.Errorf("setting `alert_details_override`: %+v", err)
+		}
+		if err := d.Set("custom_details", utils.FlattenMapStringPtrString(prop.CustomDetails)); err != nil {
+			return fmt.Errorf("setting `custom_details`: %+v", err)
+		}
+		if err := d.Set("entity_mapping", flattenAlertRuleEntityMapping(prop.EntityMappings)); err != nil {
+			return fmt.Errorf("setting `entity_mapping`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSentinelAlertRuleNrtDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AlertRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+		return fmt.Errorf("deleting Sentinel Alert Rule NRT %q: %+v", id, err)
+	}
+
+	return nil
+}
@@ -20,26 +20,6 @@ import (
 )
 
 func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
-	var entityMappingTypes = []string{
-		string(securityinsight.EntityMappingTypeAccount),
-		string(securityinsight.EntityMappingTypeAzureResource),
-		string(securityinsight.EntityMappingTypeCloudApplication),
-		string(securityinsight.EntityMappingTypeDNS),
-		string(securityinsight.EntityMappingTypeFile),
-		string(securityinsight.EntityMappingTypeFileHash),
-		string(securityinsight.EntityMappingTypeHost),
-		string(securityinsight.EntityMappingTypeIP),
-		string(securityinsight.EntityMappingTypeMailbox),
-		string(securityinsight.EntityMappingTypeMailCluster),
-		string(securityinsight.EntityMappingTypeMailMessage),
-		string(securityinsight.EntityMappingTypeMalware),
-		string(securityinsight.EntityMappingTypeProcess),
-		string(securityinsight.EntityMappingTypeRegistryKey),
-		string(securityinsight.EntityMappingTypeRegistryValue),
-		string(securityinsight.EntityMappingTypeSecurityGroup),
-		string(securityinsight.EntityMappingTypeSubmissionMail),
-		string(securityinsight.EntityMappingTypeURL),
-	}
 	return &pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleScheduledCreateUpdate,
 		Read:   resourceSentinelAlertRuleScheduledRead,
@@ -98,126 +78,11 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"event_grouping": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"aggregation_method": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(securityinsight.EventGroupingAggregationKindAlertPerResult),
-								string(securityinsight.EventGroupingAggregationKindSingleAlert),
-							}, false),
-						},
-					},
-				},
-			},
+			"event_grouping": alertRuleEventGroupingSchema(),
 
-			"tactics": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				Elem: &pluginsdk.Schema{
-					Type: pluginsdk.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{
-						string(securityinsight.AttackTacticCollection),
-						string(securityinsight.AttackTacticCommandAndControl),
-						string(securityinsight.AttackTacticCredentialAccess),
-						string(securityinsight.AttackTacticDefenseEvasion),
-						string(securityinsight.AttackTacticDiscovery),
-						string(securityinsight.AttackTacticExecution),
-						string(securityinsight.AttackTacticExfiltration),
-						string(securityinsight.AttackTacticImpact),
-						string(securityinsight.AttackTacticInitialAccess),
-						string(securityinsight.AttackTacticLateralMovement),
-						string(securityinsight.AttackTacticPersistence),
-						string(securityinsight.AttackTacticPrivilegeEscalation),
-						string(securityinsight.AttackTacticPreAttack),
-					}, false),
-				},
-			},
+			"tactics": alertRuleTacticsSchema(),
 
-			"incident_configuration": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				MinItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"create_incident": {
-							Required: true,
-							Type:     pluginsdk.TypeBool,
-						},
-						"grouping": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MaxItems: 1,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										Default:  true,
-									},
-									"lookback_duration": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validate.ISO8601Duration,
-										Default:      "PT5M",
-									},
-									"reopen_closed_incidents": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-									"entity_matching_method": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-										Default:  securityinsight.MatchingMethodAnyAlert,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(securityinsight.MatchingMethodAnyAlert),
-											string(securityinsight.MatchingMethodSelected),
-											string(securityinsight.MatchingMethodAllEntities),
-										}, false),
-									},
-									"group_by_entities": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice(entityMappingTypes, false),
-										},
-									},
-									"group_by_alert_details": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(securityinsight.AlertDetailDisplayName),
-												string(securityinsight.AlertDetailSeverity),
-											},
-												false),
-										},
-									},
-									"group_by_custom_details": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"incident_configuration": alertRuleIncidentConfigurationSchema(),
 
 			"severity": {
 				Type:     pluginsdk.TypeString,
@@ -286,75 +151,9 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 				Default:      "PT5H",
 				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "PT24H"),
 			},
-			"alert_details_override": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"description_format": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"display_name_format": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"severity_column_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"tactics_column_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-					},
-				},
-			},
-			"custom_details": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
-				Elem: &pluginsdk.Schema{
-					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
-				},
-			},
-			"entity_mapping": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 5,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"entity_type": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(entityMappingTypes, false),
-						},
-						"field_mapping": {
-							Type:     pluginsdk.TypeList,
-							MaxItems: 3,
-							Required: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"identifier": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"column_name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"alert_details_override": alertRuleAlertDetailsOverrideSchema(),
+			"custom_details":         alertRuleCustomDetailsSchema(),
+			"entity_mapping":         alertRuleEntityMappingSchema(),
 		},
 	}
 }
@@ -412,8 +211,8 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *pluginsdk.ResourceData, m
 		ScheduledAlertRuleProperties: &securityinsight.ScheduledAlertRuleProperties{
 			Description:           utils.String(d.Get("description").(string)),
 			DisplayName:           utils.String(d.Get("display_name").(string)),
-			Tactics:               expandAlertRuleScheduledTactics(d.Get("tactics").(*pluginsdk.Set).List()),
-			IncidentConfiguration: expandAlertRuleScheduledIncidentConfiguration(d.Get("incident_configuration").([]interface{})),
+			Tactics:               expandAlertRuleTactics(d.Get("tactics").(*pluginsdk.Set).List()),
+			IncidentConfiguration: expandAlertRuleIncidentConfiguration(d.Get("incident_configuration").([]interface{})),
 			Severity:              securityinsight.AlertSeverity(d.Get("severity").(string)),
 			Enabled:               utils.Bool(d.Get("enabled").(bool)),
 			Query:                 utils.String(d.Get("query").(string)),
@@ -433,16 +232,16 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *pluginsdk.ResourceData, m
 		param.ScheduledAlertRuleProperties.TemplateVersion = utils.String(v.(string))
 	}
 	if v, ok := d.GetOk("event_grouping"); ok {
-		param.ScheduledAlertRuleProperties.EventGroupingSettings = expandAlertRuleScheduledEventGroupingSetting(v.([]interface{}))
+		param.ScheduledAlertRuleProperties.EventGroupingSettings = expandAlertRuleEventGroupingSetting(v.([]interface{}))
 	}
 	if v, ok := d.GetOk("alert_details_override"); ok {
-		param.ScheduledAlertRuleProperties.AlertDetailsOverride = expandAlertRuleScheduledAlertDetailsOverride(v.([]interface{}))
+		param.ScheduledAlertRuleProperties.AlertDetailsOverride = expandAlertRuleAlertDetailsOverride(v.([]interface{}))
 	}
 	if v, ok := d.GetOk("custom_details"); ok {
 		param.ScheduledAlertRuleProperties.CustomDetails = utils.ExpandMapStringPtrString(v.(map[string]interface{}))
 	}
 	if v, ok := d.GetOk("entity_mapping"); ok {
-		param.ScheduledAlertRuleProperties.EntityMappings = expandAlertRuleScheduledEntityMapping(v.([]interface{}))
+		param.ScheduledAlertRuleProperties.EntityMappings = expandAlertRuleEntityMapping(v.([]interface{}))
 	}
 
 	if !d.IsNewResource() {
@@ -499,10 +298,10 @@ func resourceSentinelAlertRuleScheduledRead(d *pluginsdk.ResourceData, meta inte
 	if prop := rule.ScheduledAlertRuleProperties; prop != nil {
 		d.Set("description", prop.Description)
 		d.Set("display_name", prop.DisplayName)
-		if err := d.Set("tactics", flattenAlertRuleScheduledTactics(prop.Tactics)); err != nil {
+		if err := d.Set("tactics", flattenAlertRuleTactics(prop.Tactics)); err != nil {
 			return fmt.Errorf("setting `tactics`: %+v", err)
 		}
-		if err := d.Set("incident_configuration", flattenAlertRuleScheduledIncidentConfiguration(prop.IncidentConfiguration)); err != nil {
+		if err := d.Set("incident_configuration", flattenAlertRuleIncidentConfiguration(prop.IncidentConfiguration)); err != nil {
 			return fmt.Errorf("setting `incident_configuration`: %+v", err)
 		}
 		d.Set("severity", string(prop.Severity))
@@ -523,16 +322,16 @@ func resourceSentinelAlertRuleScheduledRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("alert_rule_template_guid", prop.AlertRuleTemplateName)
 		d.Set("alert_rule_template_version", prop.TemplateVersion)
 
-		if err := d.Set("event_grouping", flattenAlertRuleScheduledEventGroupingSetting(prop.EventGroupingSettings)); err != nil {
+		if err := d.Set("event_grouping", flattenAlertRuleEventGroupingSetting(prop.EventGroupingSettings)); err != nil {
 			return fmt.Errorf("setting `event_grouping`: %+v", err)
 		}
-		if err := d.Set("alert_details_override", flattenAlertRuleScheduledAlertDetailsOverride(prop.AlertDetailsOverride)); err != nil {
+		if err := d.Set("alert_details_override", flattenAlertRuleAlertDetailsOverride(prop.AlertDetailsOverride)); err != nil {
 			return fmt.Errorf("setting `alert_details_override`: %+v", err)
 		}
 		if err := d.Set("custom_details", utils.FlattenMapStringPtrString(prop.CustomDetails)); err != nil {
 			return fmt.Errorf("setting `custom_details`: %+v", err)
 		}
-		if err := d.Set("entity_mapping", flattenAlertRuleScheduledEntityMapping(prop.EntityMappings)); err != nil {
+		if err := d.Set("entity_mapping", flattenAlertRuleEntityMapping(prop.EntityMappings)); err != nil {
 			return fmt.Errorf("setting `entity_mapping`: %+v", err)
 		}
 	}
@@ -556,318 +355,3 @@ func resourceSentinelAlertRuleScheduledDelete(d *pluginsdk.ResourceData, meta in
 
 	return nil
 }
-
-func expandAlertRuleScheduledTactics(input []interface{}) *[]securityinsight.AttackTactic {
-	result := make([]securityinsight.AttackTactic, 0)
-
-	for _, e := range input {
-		result = append(result, securityinsight.AttackTactic(e.(string)))
-	}
-
-	return &result
-}
-
-func flattenAlertRuleScheduledTactics(input *[]securityinsight.AttackTactic) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	output := make([]interface{}, 0)
-
-	for _, e := range *input {
-		output = append(output, string(e))
-	}
-
-	return output
-}
-
-func expandAlertRuleScheduledIncidentConfiguration(input []interface{}) *securityinsight.IncidentConfiguration {
-	if len(input) == 0 || input[0] == nil {
-		return nil
-	}
-
-	raw := input[0].(map[string]interface{})
-
-	output := &securityinsight.IncidentConfiguration{
-		CreateIncident:        utils.Bool(raw["create_incident"].(bool)),
-		GroupingConfiguration: expandAlertRuleScheduledGrouping(raw["grouping"].([]interface{})),
-	}
-
-	return output
-}
-
-func flattenAlertRuleScheduledIncidentConfiguration(input *securityinsight.IncidentConfiguration) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	createIncident := false
-	if input.CreateIncident != nil {
-		createIncident = *input.CreateIncident
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"create_incident": createIncident,
-			"grouping":        flattenAlertRuleScheduledGrouping(input.GroupingConfiguration),
-		},
-	}
-}
-
-func expandAlertRuleScheduledGrouping(input []interface{}) *securityinsight.GroupingConfiguration {
-	if len(input) == 0 || input[0] == nil {
-		return nil
-	}
-
-	raw := input[0].(map[string]interface{})
-
-	output := &securityinsight.GroupingConfiguration{
-		Enabled:              utils.Bool(raw["enabled"].(bool)),
-		ReopenClosedIncident: utils.Bool(raw["reopen_closed_incidents"].(bool)),
-		LookbackDuration:     utils.String(raw["lookback_duration"].(string)),
-		MatchingMethod:       securityinsight.MatchingMethod(raw["entity_matching_method"].(string)),
-	}
-
-	groupByEntitiesList := raw["group_by_entities"].([]interface{})
-	groupByEntities := make([]securityinsight.EntityMappingType, len(groupByEntitiesList))
-	for idx, t := range groupByEntitiesList {
-		groupByEntities[idx] = securityinsight.EntityMappingType(t.(string))
-	}
-	output.GroupByEntities = &groupByEntities
-
-	groupByAlertDetailsList := raw["group_by_alert_details"].([]interface{})
-	groupByAlertDetails := make([]securityinsight.AlertDetail, len(groupByAlertDetailsList))
-	for idx, t := range groupByAlertDetailsList {
-		groupByAlertDetails[idx] = securityinsight.AlertDetail(t.(string))
-	}
-	output.GroupByAlertDetails = &groupByAlertDetails
-
-	output.GroupByCustomDetails = utils.ExpandStringSlice(raw["group_by_custom_details"].([]interface{}))
-
-	return output
-}
-
-func expandAlertRuleScheduledEventGroupingSetting(input []interface{}) *securityinsight.EventGroupingSettings {
-	if len(input) == 0 || input[0] == nil {
-		return nil
-	}
-
-	v := input[0].(map[string]interface{})
-	result := securityinsight.EventGroupingSettings{}
-
-	if aggregationKind := v["aggregation_method"].(string); aggregationKind != "" {
-		result.AggregationKind = securityinsight.EventGroupingAggregationKind(aggregationKind)
-	}
-
-	return &result
-}
-
-func flattenAlertRuleScheduledGrouping(input *securityinsight.GroupingConfiguration) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	enabled := false
-	if input.Enabled != nil {
-		enabled = *input.Enabled
-	}
-
-	lookbackDuration := ""
-	if input.LookbackDuration != nil {
-		lookbackDuration = *input.LookbackDuration
-	}
-
-	reopenClosedIncidents := false
-	if input.ReopenClosedIncident != nil {
-		reopenClosedIncidents = *input.ReopenClosedIncident
-	}
-
-	var groupByEntities []interface{}
-	if input.GroupByEntities != nil {
-		for _, entity := range *input.GroupByEntities {
-			groupByEntities = append(groupByEntities, string(entity))
-		}
-	}
-
-	var groupByAlertDetails []interface{}
-	if input.GroupByAlertDetails != nil {
-		for _, detail := range *input.GroupByAlertDetails {
-			groupByAlertDetails = append(groupByAlertDetails, string(detail))
-		}
-	}
-
-	var groupByCustomDetails []interface{}
-	if input.GroupByCustomDetails != nil {
-		for _, detail := range *input.GroupByCustomDetails {
-			groupByCustomDetails = append(groupByCustomDetails, detail)
-		}
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"enabled":                 enabled,
-			"lookback_duration":       lookbackDuration,
-			"reopen_closed_incidents": reopenClosedIncidents,
-			"entity_matching_method":  string(input.MatchingMethod),
-			"group_by_entities":       groupByEntities,
-			"group_by_alert_details":  groupByAlertDetails,
-			"group_by_custom_details": groupByCustomDetails,
-		},
-	}
-}
-
-func flattenAlertRuleScheduledEventGroupingSetting(input *securityinsight.EventGroupingSettings) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	var aggregationKind string
-	if input.AggregationKind != "" {
-		aggregationKind = string(input.AggregationKind)
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"aggregation_method": aggregationKind,
-		},
-	}
-}
-
-func expandAlertRuleScheduledAlertDetailsOverride(input []interface{}) *securityinsight.AlertDetailsOverride {
-	if len(input) == 0 || input[0] == nil {
-		return nil
-	}
-
-	b := input[0].(map[string]interface{})
-	output := &securityinsight.AlertDetailsOverride{}
-
-	if v := b["description_format"]; v != "" {
-		output.AlertDescriptionFormat = utils.String(v.(string))
-	}
-	if v := b["display_name_format"]; v != "" {
-		output.AlertDisplayNameFormat = utils.String(v.(string))
-	}
-	if v := b["severity_column_name"]; v != "" {
-		output.AlertSeverityColumnName = utils.String(v.(string))
-	}
-	if v := b["tactics_column_name"]; v != "" {
-		output.AlertTacticsColumnName = utils.String(v.(string))
-	}
-
-	return output
-}
-
-func flattenAlertRuleScheduledAlertDetailsOverride(input *securityinsight.AlertDetailsOverride) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	var descriptionFormat string
-	if input.AlertDescriptionFormat != nil {
-		descriptionFormat = *input.AlertDescriptionFormat
-	}
-
-	var displayNameFormat string
-	if input.AlertDisplayNameFormat != nil {
-		displayNameFormat = *input.AlertDisplayNameFormat
-	}
-
-	var severityColumnName string
-	if input.AlertSeverityColumnName != nil {
-		severityColumnName = *input.AlertSeverityColumnName
-	}
-
-	var tacticsColumnName string
-	if input.AlertTacticsColumnName != nil {
-		tacticsColumnName = *input.AlertTacticsColumnName
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"description_format":   descriptionFormat,
-			"display_name_format":  displayNameFormat,
-			"severity_column_name": severityColumnName,
-			"tactics_column_name":  tacticsColumnName,
-		},
-	}
-}
-
-func expandAlertRuleScheduledEntityMapping(input []interface{}) *[]securityinsight.EntityMapping {
-	if len(input) == 0 {
-		return nil
-	}
-
-	result := make([]securityinsight.EntityMapping, 0)
-
-	for _, e := range input {
-		b := e.(map[string]interface{})
-		result = append(result, securityinsight.EntityMapping{
-			EntityType:    securityinsight.EntityMappingType(b["entity_type"].(string)),
-			FieldMappings: expandAlertRuleScheduledFieldMapping(b["field_mapping"].([]interface{})),
-		})
-	}
-
-	return &result
-}
-
-func flattenAlertRuleScheduledEntityMapping(input *[]securityinsight.EntityMapping) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	output := make([]interface{}, 0)
-
-	for _, e := range *input {
-		output = append(output, map[string]interface{}{
-			"entity_type":   string(e.EntityType),
-			"field_mapping": flattenAlertRuleScheduledFieldMapping(e.FieldMappings),
-		})
-	}
-
-	return output
-}
-
-func expandAlertRuleScheduledFieldMapping(input []interface{}) *[]securityinsight.FieldMapping {
-	if len(input) == 0 {
-		return nil
-	}
-
-	result := make([]securityinsight.FieldMapping, 0)
-
-	for _, e := range input {
-		b := e.(map[string]interface{})
-		result = append(result, securityinsight.FieldMapping{
-			Identifier: utils.String(b["identifier"].(string)),
-			ColumnName: utils.String(b["column_name"].(string)),
-		})
-	}
-
-	return &result
-}
-
-func flattenAlertRuleScheduledFieldMapping(input *[]securityinsight.FieldMapping) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	output := make([]interface{}, 0)
-
-	for _, e := range *input {
-		var identifier string
-		if e.Identifier != nil {
-			identifier = *e.Identifier
-		}
-
-		var columnName string
-		if e.ColumnName != nil {
-			columnName = *e.ColumnName
-		}
-
-		output = append(output, map[string]interface{}{
-			"identifier":  identifier,
-			"column_name": columnName,
-		})
-	}
-
-	return output
-}
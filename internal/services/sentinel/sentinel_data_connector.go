@@ -3,6 +3,7 @@ package sentinel
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -32,7 +33,7 @@ func importSentinelDataConnector(expectKind securityinsight.DataConnectorKind) p
 
 func assertDataConnectorKind(dc securityinsight.BasicDataConnector, expectKind securityinsight.DataConnectorKind) error {
 	var kind securityinsight.DataConnectorKind
-	switch dc.(type) {
+	switch v := dc.(type) {
 	case securityinsight.AADDataConnector:
 		kind = securityinsight.DataConnectorKindAzureActiveDirectory
 	case securityinsight.AATPDataConnector:
@@ -51,9 +52,35 @@ func assertDataConnectorKind(dc securityinsight.BasicDataConnector, expectKind s
 		kind = securityinsight.DataConnectorKindAmazonWebServicesCloudTrail
 	case securityinsight.MDATPDataConnector:
 		kind = securityinsight.DataConnectorKindMicrosoftDefenderAdvancedThreatProtection
+	case securityinsight.OfficeIRMDataConnector:
+		kind = securityinsight.DataConnectorKindOfficeIRM
+	case securityinsight.Dynamics365DataConnector:
+		kind = securityinsight.DataConnectorKindDynamics365
+	case securityinsight.MSTIDataConnector:
+		kind = securityinsight.DataConnectorKindMicrosoftThreatIntelligence
+	case securityinsight.MTPDataConnector:
+		kind = securityinsight.DataConnectorKindMicrosoftThreatProtection
+	case securityinsight.TiTaxiiDataConnector:
+		kind = securityinsight.DataConnectorKindThreatIntelligenceTaxii
+	case securityinsight.DataConnector:
+		// kinds which aren't present in the SDK (e.g. IoT) are unmarshalled into the base Data Connector
+		kind = securityinsight.DataConnectorKind(v.Kind)
 	}
 	if expectKind != kind {
 		return fmt.Errorf("Sentinel Data Connector has mismatched kind, expected: %q, got %q", expectKind, kind)
 	}
 	return nil
 }
+
+// flattenDataConnectorLookbackDate normalizes the lookback date returned by the API (which may include fractional seconds) to RFC3339
+func flattenDataConnectorLookbackDate(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	t, err := time.Parse(time.RFC3339, *input)
+	if err != nil {
+		return *input
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,274 @@
+package sentinel
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSentinelDataConnectorThreatIntelligenceTaxii() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSentinelDataConnectorThreatIntelligenceTaxiiCreateUpdate,
+		Read:   resourceSentinelDataConnectorThreatIntelligenceTaxiiRead,
+		Update: resourceSentinelDataConnectorThreatIntelligenceTaxiiCreateUpdate,
+		Delete: resourceSentinelDataConnectorThreatIntelligenceTaxiiDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.DataConnectorID(id)
+			return err
+		}, importSentinelDataConnector(securityinsight.DataConnectorKindThreatIntelligenceTaxii)),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"api_root_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"user_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"polling_frequency": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(securityinsight.PollingFrequencyOnceAnHour),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(securityinsight.PollingFrequencyOnceAMinute),
+					string(securityinsight.PollingFrequencyOnceAnHour),
+					string(securityinsight.PollingFrequencyOnceADay),
+				}, false),
+			},
+
+			"lookback_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "1970-01-01T00:00:00Z",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func resourceSentinelDataConnectorThreatIntelligenceTaxiiCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	workspaceClient := meta.(*clients.Client).LogAnalytics.WorkspacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	id := parse.NewDataConnectorID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, name)
+
+	if d.IsNewResource() {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return tf.ImportAsExistsError("azurerm_sentinel_data_connector_threat_intelligence_taxii", id.ID())
+		}
+	}
+
+	// the API requires the Workspace ID (also known as the Customer ID) rather than the Resource ID of the Workspace
+	workspace, err := workspaceClient.Get(ctx, workspaceId.ResourceGroup, workspaceId.WorkspaceName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+	if workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.CustomerID == nil {
+		return fmt.Errorf("retrieving %s: `properties.customerId` was nil", workspaceId)
+	}
+
+	tenantId := d.Get("tenant_id").(string)
+	if tenantId == "" {
+		tenantId = meta.(*clients.Client).Account.TenantId
+	}
+
+	lookbackDate, err := time.Parse(time.RFC3339, d.Get("lookback_date").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `lookback_date`: %+v", err)
+	}
+
+	param := securityinsight.TiTaxiiDataConnector{
+		Name: &name,
+		TiTaxiiDataConnectorProperties: &securityinsight.TiTaxiiDataConnectorProperties{
+			WorkspaceID:         workspace.WorkspaceProperties.CustomerID,
+			FriendlyName:        utils.String(d.Get("display_name").(string)),
+			TaxiiServer:         utils.String(d.Get("api_root_url").(string)),
+			CollectionID:        utils.String(d.Get("collection_id").(string)),
+			TaxiiLookbackPeriod: &date.Time{Time: lookbackDate},
+			PollingFrequency:    securityinsight.PollingFrequency(d.Get("polling_frequency").(string)),
+			TenantID:            &tenantId,
+			DataTypes: &securityinsight.TiTaxiiDataConnectorDataTypes{
+				TaxiiClient: &securityinsight.TiTaxiiDataConnectorDataTypesTaxiiClient{
+					State: securityinsight.DataTypeStateEnabled,
+				},
+			},
+		},
+		Kind: securityinsight.KindBasicDataConnectorKindThreatIntelligenceTaxii,
+	}
+
+	if v := d.Get("user_name").(string); v != "" {
+		param.TiTaxiiDataConnectorProperties.UserName = utils.String(v)
+	}
+
+	if v := d.Get("password").(string); v != "" {
+		param.TiTaxiiDataConnectorProperties.Password = utils.String(v)
+	}
+
+	if !d.IsNewResource() {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if _, ok := resp.Value.(securityinsight.TiTaxiiDataConnector); !ok {
+			return fmt.Errorf("%s was not a Threat Intelligence TAXII Data Connector", id)
+		}
+	}
+
+	if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSentinelDataConnectorThreatIntelligenceTaxiiRead(d, meta)
+}
+
+func resourceSentinelDataConnectorThreatIntelligenceTaxiiRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataConnectorID(d.Id())
+	if err != nil {
+		return err
+	}
+	workspaceId := loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dc, ok := resp.Value.(securityinsight.TiTaxiiDataConnector)
+	if !ok {
+		return fmt.Errorf("%s was not a Threat Intelligence TAXII Data Connector", id)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("log_analytics_workspace_id", workspaceId.ID())
+
+	if props := dc.TiTaxiiDataConnectorProperties; props != nil {
+		d.Set("display_name", props.FriendlyName)
+		d.Set("api_root_url", props.TaxiiServer)
+		d.Set("collection_id", props.CollectionID)
+		d.Set("polling_frequency", string(props.PollingFrequency))
+		d.Set("tenant_id", props.TenantID)
+
+		lookbackDate := ""
+		if props.TaxiiLookbackPeriod != nil {
+			lookbackDate = props.TaxiiLookbackPeriod.Format(time.RFC3339)
+		}
+		d.Set("lookback_date", lookbackDate)
+
+		// the password isn't returned by the API and the user name may not be either, so these are preserved from the config
+		if props.UserName != nil {
+			d.Set("user_name", props.UserName)
+		}
+	}
+
+	return nil
+}
+
+func resourceSentinelDataConnectorThreatIntelligenceTaxiiDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataConnectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
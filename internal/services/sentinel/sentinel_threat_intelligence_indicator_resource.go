@@ -0,0 +1,448 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// threatIntelligenceIndicatorThreatIntelligenceName is the only Threat Intelligence within a Workspace
+const threatIntelligenceIndicatorThreatIntelligenceName = "main"
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+var _ sdk.ResourceWithUpdate = ThreatIntelligenceIndicatorResource{}
+
+type ThreatIntelligenceIndicatorModel struct {
+	Name               string                                         `tfschema:"name"`
+	WorkspaceId        string                                         `tfschema:"workspace_id"`
+	DisplayName        string                                         `tfschema:"display_name"`
+	Description        string                                         `tfschema:"description"`
+	PatternType        string                                         `tfschema:"pattern_type"`
+	Pattern            string                                         `tfschema:"pattern"`
+	Source             string                                         `tfschema:"source"`
+	ValidFrom          string                                         `tfschema:"valid_from"`
+	ValidUntil         string                                         `tfschema:"valid_until"`
+	Confidence         *int64                                         `tfschema:"confidence"`
+	KillChainPhases    []ThreatIntelligenceIndicatorKillChainPhase    `tfschema:"kill_chain_phase"`
+	ExternalReferences []ThreatIntelligenceIndicatorExternalReference `tfschema:"external_reference"`
+	Labels             []string                                       `tfschema:"labels"`
+	CreatedOn          string                                         `tfschema:"created_on"`
+	LastUpdatedOn      string                                         `tfschema:"last_updated_on"`
+}
+
+type ThreatIntelligenceIndicatorKillChainPhase struct {
+	KillChainName string `tfschema:"kill_chain_name"`
+	PhaseName     string `tfschema:"phase_name"`
+}
+
+type ThreatIntelligenceIndicatorExternalReference struct {
+	SourceName  string            `tfschema:"source_name"`
+	Description string            `tfschema:"description"`
+	ExternalId  string            `tfschema:"external_id"`
+	Url         string            `tfschema:"url"`
+	Hashes      map[string]string `tfschema:"hashes"`
+}
+
+func (r ThreatIntelligenceIndicatorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"pattern_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(threatIntelligenceIndicatorPatternTypes, false),
+		},
+		"pattern": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"valid_from": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppress.RFC3339Time,
+		},
+		"valid_until": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppress.RFC3339Time,
+		},
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"confidence": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		"kill_chain_phase": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kill_chain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"phase_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+		"external_reference": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"external_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+					"hashes": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"labels": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"created_on": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"last_updated_on": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) ResourceType() string {
+	return "azurerm_sentinel_threat_intelligence_indicator"
+}
+
+func (r ThreatIntelligenceIndicatorResource) ModelObject() interface{} {
+	return &ThreatIntelligenceIndicatorModel{}
+}
+
+func (r ThreatIntelligenceIndicatorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ThreatIntelligenceIndicatorID
+}
+
+func (r ThreatIntelligenceIndicatorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			// the name of the Indicator is generated by the API, so there's no existing resource to check for
+			resp, err := azuresdkhacks.CreateThreatIntelligenceIndicator(ctx, client, workspaceId.ResourceGroup, workspaceId.WorkspaceName, expandThreatIntelligenceIndicator(model))
+			if err != nil {
+				return fmt.Errorf("creating Threat Intelligence Indicator within %s: %+v", workspaceId, err)
+			}
+			if resp.Name == nil || *resp.Name == "" {
+				return fmt.Errorf("creating Threat Intelligence Indicator within %s: `name` was nil", workspaceId)
+			}
+
+			id := parse.NewThreatIntelligenceIndicatorID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, threatIntelligenceIndicatorThreatIntelligenceName, *resp.Name)
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			indicator, ok := resp.Value.(securityinsight.ThreatIntelligenceIndicatorModel)
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected an Indicator but got %+v", id, resp.Value)
+			}
+
+			model := ThreatIntelligenceIndicatorModel{
+				Name:        id.IndicatorName,
+				WorkspaceId: loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			if props := indicator.ThreatIntelligenceIndicatorProperties; props != nil {
+				model.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.PatternType = utils.NormalizeNilableString(props.PatternType)
+				model.Pattern = utils.NormalizeNilableString(props.Pattern)
+				model.Source = utils.NormalizeNilableString(props.Source)
+				model.ValidFrom = utils.NormalizeNilableString(props.ValidFrom)
+				model.ValidUntil = utils.NormalizeNilableString(props.ValidUntil)
+				model.KillChainPhases = flattenThreatIntelligenceIndicatorKillChainPhases(props.KillChainPhases)
+				model.ExternalReferences = flattenThreatIntelligenceIndicatorExternalReferences(props.ExternalReferences)
+				model.CreatedOn = utils.NormalizeNilableString(props.Created)
+				model.LastUpdatedOn = utils.NormalizeNilableString(props.LastUpdatedTimeUtc)
+
+				if props.Confidence != nil {
+					model.Confidence = utils.Int64(int64(*props.Confidence))
+				}
+				if props.Labels != nil {
+					model.Labels = *props.Labels
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName, expandThreatIntelligenceIndicator(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+var threatIntelligenceIndicatorPatternTypes = []string{
+	"domain-name",
+	"file",
+	"ipv4-addr",
+	"ipv6-addr",
+	"url",
+}
+
+func expandThreatIntelligenceIndicator(model ThreatIntelligenceIndicatorModel) securityinsight.ThreatIntelligenceIndicatorModelForRequestBody {
+	props := &securityinsight.ThreatIntelligenceIndicatorProperties{
+		DisplayName:        utils.String(model.DisplayName),
+		PatternType:        utils.String(model.PatternType),
+		Pattern:            utils.String(model.Pattern),
+		Source:             utils.String(model.Source),
+		ValidFrom:          utils.String(model.ValidFrom),
+		KillChainPhases:    expandThreatIntelligenceIndicatorKillChainPhases(model.KillChainPhases),
+		ExternalReferences: expandThreatIntelligenceIndicatorExternalReferences(model.ExternalReferences),
+		Labels:             &model.Labels,
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+	if model.ValidUntil != "" {
+		props.ValidUntil = utils.String(model.ValidUntil)
+	}
+	if model.Confidence != nil {
+		props.Confidence = utils.Int32(int32(*model.Confidence))
+	}
+
+	return securityinsight.ThreatIntelligenceIndicatorModelForRequestBody{
+		Kind:                                  utils.String(string(securityinsight.ThreatIntelligenceResourceKindEnumIndicator)),
+		ThreatIntelligenceIndicatorProperties: props,
+	}
+}
+
+func expandThreatIntelligenceIndicatorKillChainPhases(input []ThreatIntelligenceIndicatorKillChainPhase) *[]securityinsight.ThreatIntelligenceKillChainPhase {
+	output := make([]securityinsight.ThreatIntelligenceKillChainPhase, 0)
+	for _, v := range input {
+		output = append(output, securityinsight.ThreatIntelligenceKillChainPhase{
+			KillChainName: utils.String(v.KillChainName),
+			PhaseName:     utils.String(v.PhaseName),
+		})
+	}
+	return &output
+}
+
+func flattenThreatIntelligenceIndicatorKillChainPhases(input *[]securityinsight.ThreatIntelligenceKillChainPhase) []ThreatIntelligenceIndicatorKillChainPhase {
+	output := make([]ThreatIntelligenceIndicatorKillChainPhase, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ThreatIntelligenceIndicatorKillChainPhase{
+			KillChainName: utils.NormalizeNilableString(v.KillChainName),
+			PhaseName:     utils.NormalizeNilableString(v.PhaseName),
+		})
+	}
+	return output
+}
+
+func expandThreatIntelligenceIndicatorExternalReferences(input []ThreatIntelligenceIndicatorExternalReference) *[]securityinsight.ThreatIntelligenceExternalReference {
+	output := make([]securityinsight.ThreatIntelligenceExternalReference, 0)
+	for _, v := range input {
+		reference := securityinsight.ThreatIntelligenceExternalReference{}
+		if v.SourceName != "" {
+			reference.SourceName = utils.String(v.SourceName)
+		}
+		if v.Description != "" {
+			reference.Description = utils.String(v.Description)
+		}
+		if v.ExternalId != "" {
+			reference.ExternalID = utils.String(v.ExternalId)
+		}
+		if v.Url != "" {
+			reference.URL = utils.String(v.Url)
+		}
+		if len(v.Hashes) != 0 {
+			reference.Hashes = make(map[string]*string)
+			for algorithm, hash := range v.Hashes {
+				reference.Hashes[algorithm] = utils.String(hash)
+			}
+		}
+		output = append(output, reference)
+	}
+	return &output
+}
+
+func flattenThreatIntelligenceIndicatorExternalReferences(input *[]securityinsight.ThreatIntelligenceExternalReference) []ThreatIntelligenceIndicatorExternalReference {
+	output := make([]ThreatIntelligenceIndicatorExternalReference, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		hashes := make(map[string]string)
+		for algorithm, hash := range v.Hashes {
+			if hash != nil {
+				hashes[algorithm] = *hash
+			}
+		}
+
+		output = append(output, ThreatIntelligenceIndicatorExternalReference{
+			SourceName:  utils.NormalizeNilableString(v.SourceName),
+			Description: utils.NormalizeNilableString(v.Description),
+			ExternalId:  utils.NormalizeNilableString(v.ExternalID),
+			Url:         utils.NormalizeNilableString(v.URL),
+			Hashes:      hashes,
+		})
+	}
+	return output
+}
@@ -0,0 +1,176 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+func TestAccThreatIntelligenceIndicator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ThreatIntelligenceIndicatorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.ThreatIntelligenceClient
+
+	id, err := parse.ThreatIntelligenceIndicatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ThreatIntelligenceIndicatorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name = "acctest-indicator-%d"
+  pattern_type = "ipv4-addr"
+  pattern      = "[ipv4-addr:value = '10.0.0.1']"
+  source       = "acctest-source-%d"
+  valid_from   = "2022-12-14T16:00:00Z"
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name = "acctest-indicator-updated-%d"
+  description  = "an indicator for a known malicious domain"
+  pattern_type = "domain-name"
+  pattern      = "[domain-name:value = 'malicious.example.com']"
+  source       = "acctest-source-%d"
+  valid_from   = "2022-12-14T16:00:00Z"
+  valid_until  = "2032-12-14T16:00:00Z"
+  confidence   = 80
+  labels       = ["malicious-activity", "phishing"]
+
+  kill_chain_phase {
+    kill_chain_name = "lockheed-martin-cyber-kill-chain"
+    phase_name      = "reconnaissance"
+  }
+
+  external_reference {
+    source_name = "example-feed"
+    description = "the feed which reported this indicator"
+    external_id = "example-1234"
+    url         = "https://www.example.com/indicators/1234"
+    hashes = {
+      "SHA-256" = "6db12788c37247f2316052e142f42f4b259d6561751e5f401a1ae2a6df9c674b"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_solution" "sentinel" {
+  solution_name         = "SecurityInsights"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  workspace_resource_id = azurerm_log_analytics_workspace.test.id
+  workspace_name        = azurerm_log_analytics_workspace.test.name
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/SecurityInsights"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
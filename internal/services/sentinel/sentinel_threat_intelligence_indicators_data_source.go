@@ -0,0 +1,216 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ThreatIntelligenceIndicatorsDataSource struct{}
+
+var _ sdk.DataSource = ThreatIntelligenceIndicatorsDataSource{}
+
+type ThreatIntelligenceIndicatorsDataSourceModel struct {
+	WorkspaceId string                                       `tfschema:"workspace_id"`
+	Pattern     string                                       `tfschema:"pattern"`
+	PatternType string                                       `tfschema:"pattern_type"`
+	Source      string                                       `tfschema:"source"`
+	Indicators  []ThreatIntelligenceIndicatorDataSourceModel `tfschema:"indicators"`
+}
+
+type ThreatIntelligenceIndicatorDataSourceModel struct {
+	Id          string   `tfschema:"id"`
+	Name        string   `tfschema:"name"`
+	DisplayName string   `tfschema:"display_name"`
+	Description string   `tfschema:"description"`
+	PatternType string   `tfschema:"pattern_type"`
+	Pattern     string   `tfschema:"pattern"`
+	Source      string   `tfschema:"source"`
+	ValidFrom   string   `tfschema:"valid_from"`
+	ValidUntil  string   `tfschema:"valid_until"`
+	Confidence  int64    `tfschema:"confidence"`
+	Labels      []string `tfschema:"labels"`
+}
+
+func (d ThreatIntelligenceIndicatorsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+		"pattern": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"pattern_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(threatIntelligenceIndicatorPatternTypes, false),
+		},
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d ThreatIntelligenceIndicatorsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"indicators": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"pattern_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"pattern": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"source": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"valid_from": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"valid_until": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"confidence": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"labels": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ThreatIntelligenceIndicatorsDataSource) ModelObject() interface{} {
+	return &ThreatIntelligenceIndicatorsDataSourceModel{}
+}
+
+func (d ThreatIntelligenceIndicatorsDataSource) ResourceType() string {
+	return "azurerm_sentinel_threat_intelligence_indicators"
+}
+
+func (d ThreatIntelligenceIndicatorsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			var model ThreatIntelligenceIndicatorsDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			criteria := securityinsight.ThreatIntelligenceFilteringCriteria{
+				PageSize: utils.Int32(100),
+			}
+			if model.PatternType != "" {
+				criteria.PatternTypes = &[]string{model.PatternType}
+			}
+			if model.Source != "" {
+				criteria.Sources = &[]string{model.Source}
+			}
+
+			indicators, err := azuresdkhacks.QueryThreatIntelligenceIndicators(ctx, client, workspaceId.ResourceGroup, workspaceId.WorkspaceName, criteria)
+			if err != nil {
+				return fmt.Errorf("querying Threat Intelligence Indicators within %s: %+v", workspaceId, err)
+			}
+
+			model.Indicators = flattenThreatIntelligenceIndicatorsDataSource(*workspaceId, indicators, model.Pattern)
+
+			metadata.SetID(workspaceId)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// flattenThreatIntelligenceIndicatorsDataSource flattens the Indicators, filtering them on the pattern (when specified)
+// since the API only supports filtering on a keyword, rather than matching the pattern exactly
+func flattenThreatIntelligenceIndicatorsDataSource(workspaceId loganalyticsParse.LogAnalyticsWorkspaceId, input []azuresdkhacks.ThreatIntelligenceIndicatorModel, pattern string) []ThreatIntelligenceIndicatorDataSourceModel {
+	output := make([]ThreatIntelligenceIndicatorDataSourceModel, 0)
+
+	for _, v := range input {
+		if v.Name == nil || v.ThreatIntelligenceIndicatorProperties == nil {
+			continue
+		}
+		props := v.ThreatIntelligenceIndicatorProperties
+
+		if pattern != "" && strings.TrimSpace(utils.NormalizeNilableString(props.Pattern)) != strings.TrimSpace(pattern) {
+			continue
+		}
+
+		indicator := ThreatIntelligenceIndicatorDataSourceModel{
+			Id:          parse.NewThreatIntelligenceIndicatorID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, threatIntelligenceIndicatorThreatIntelligenceName, *v.Name).ID(),
+			Name:        *v.Name,
+			DisplayName: utils.NormalizeNilableString(props.DisplayName),
+			Description: utils.NormalizeNilableString(props.Description),
+			PatternType: utils.NormalizeNilableString(props.PatternType),
+			Pattern:     utils.NormalizeNilableString(props.Pattern),
+			Source:      utils.NormalizeNilableString(props.Source),
+			ValidFrom:   utils.NormalizeNilableString(props.ValidFrom),
+			ValidUntil:  utils.NormalizeNilableString(props.ValidUntil),
+			Labels:      make([]string, 0),
+		}
+		if props.Confidence != nil {
+			indicator.Confidence = int64(*props.Confidence)
+		}
+		if props.Labels != nil {
+			indicator.Labels = *props.Labels
+		}
+
+		output = append(output, indicator)
+	}
+
+	return output
+}
@@ -0,0 +1,39 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ThreatIntelligenceIndicatorsDataSource struct{}
+
+func TestAccThreatIntelligenceIndicatorsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_threat_intelligence_indicators", "test")
+	r := ThreatIntelligenceIndicatorsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("indicators.#").HasValue("1"),
+				check.That(data.ResourceName).Key("indicators.0.id").Exists(),
+				check.That(data.ResourceName).Key("indicators.0.pattern_type").HasValue("ipv4-addr"),
+			),
+		},
+	})
+}
+
+func (ThreatIntelligenceIndicatorsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_threat_intelligence_indicators" "test" {
+  workspace_id = azurerm_sentinel_threat_intelligence_indicator.test.workspace_id
+  source       = azurerm_sentinel_threat_intelligence_indicator.test.source
+  pattern      = azurerm_sentinel_threat_intelligence_indicator.test.pattern
+}
+`, ThreatIntelligenceIndicatorResource{}.basic(data))
+}
@@ -0,0 +1,254 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+)
+
+const (
+	// watchlistItemBatchSize is the number of Watchlist Items which are upserted/deleted before checking for failures
+	watchlistItemBatchSize = 500
+
+	// watchlistItemParallelism is the number of Watchlist Items which are upserted/deleted concurrently
+	watchlistItemParallelism = 10
+)
+
+// watchlistItemOperation is either an upsert of the Watchlist Item with the specified properties,
+// or (when the properties are nil) a delete of the Watchlist Item
+type watchlistItemOperation struct {
+	name       string
+	properties map[string]interface{}
+}
+
+// readWatchlistSource returns the CSV content from either `source_content` or the file at `source_file`
+func readWatchlistSource(content, file string) (string, error) {
+	if file == "" {
+		return content, nil
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("reading `source_file` %q: %+v", file, err)
+	}
+	return string(b), nil
+}
+
+func watchlistSourceHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// parseWatchlistSource parses the CSV content into the properties for each Watchlist Item, keyed by the value
+// of the `itemSearchKey` column. The first row of the CSV content is the header containing the column names.
+func parseWatchlistSource(content, itemSearchKey string) (map[string]map[string]interface{}, error) {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(content, "\ufeff")))
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("the source content must contain a header row")
+		}
+		return nil, fmt.Errorf("reading the header row: %+v", err)
+	}
+
+	searchKeyIndex := -1
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if header[i] == itemSearchKey {
+			searchKeyIndex = i
+		}
+	}
+	if searchKeyIndex == -1 {
+		return nil, fmt.Errorf("the item search key %q was not found in the header row (%s)", itemSearchKey, strings.Join(header, ", "))
+	}
+
+	items := make(map[string]map[string]interface{})
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading the source content: %+v", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		key := row[searchKeyIndex]
+		if key == "" {
+			return nil, fmt.Errorf("the item search key %q is empty on line %d", itemSearchKey, line)
+		}
+		if _, exists := items[key]; exists {
+			return nil, fmt.Errorf("the item search key %q has the duplicate value %q on line %d", itemSearchKey, key, line)
+		}
+
+		properties := make(map[string]interface{}, len(header))
+		for i, column := range header {
+			properties[column] = row[i]
+		}
+		items[key] = properties
+	}
+
+	return items, nil
+}
+
+// diffWatchlistItems returns the operations required to make the existing Watchlist Items match the desired items,
+// matching them on the value of the `itemSearchKey` property. Existing items which aren't desired are deleted.
+func diffWatchlistItems(desired map[string]map[string]interface{}, existing []securityinsight.WatchlistItem, itemSearchKey string) []watchlistItemOperation {
+	operations := make([]watchlistItemOperation, 0)
+	found := make(map[string]bool)
+
+	for _, item := range existing {
+		if item.Name == nil {
+			continue
+		}
+
+		var properties map[string]interface{}
+		if props := item.WatchlistItemProperties; props != nil {
+			properties, _ = props.ItemsKeyValue.(map[string]interface{})
+		}
+
+		key := ""
+		if v, ok := properties[itemSearchKey]; ok && v != nil {
+			key = fmt.Sprint(v)
+		}
+
+		desiredProperties, ok := desired[key]
+		if !ok || found[key] {
+			operations = append(operations, watchlistItemOperation{
+				name: *item.Name,
+			})
+			continue
+		}
+		found[key] = true
+
+		if !watchlistItemPropertiesEqual(properties, desiredProperties) {
+			operations = append(operations, watchlistItemOperation{
+				name:       *item.Name,
+				properties: desiredProperties,
+			})
+		}
+	}
+
+	keys := make([]string, 0)
+	for key := range desired {
+		if !found[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		operations = append(operations, watchlistItemOperation{
+			name:       uuid.New().String(),
+			properties: desired[key],
+		})
+	}
+
+	return operations
+}
+
+func watchlistItemPropertiesEqual(existing, desired map[string]interface{}) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+
+	for k, v := range desired {
+		existingValue, ok := existing[k]
+		if !ok || fmt.Sprint(existingValue) != fmt.Sprint(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// syncWatchlistItems upserts/deletes the Watchlist Items within the specified Watchlist so that they match the
+// desired items, in batches of parallel requests
+func syncWatchlistItems(ctx context.Context, client *securityinsight.WatchlistItemsClient, id parse.WatchlistId, itemSearchKey string, desired map[string]map[string]interface{}) error {
+	existing := make([]securityinsight.WatchlistItem, 0)
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing items within %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		existing = append(existing, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing items within %s: %+v", id, err)
+		}
+	}
+
+	operations := diffWatchlistItems(desired, existing, itemSearchKey)
+	log.Printf("[DEBUG] Applying %d item changes to %s (%d existing items, %d desired items)", len(operations), id, len(existing), len(desired))
+
+	for start := 0; start < len(operations); start += watchlistItemBatchSize {
+		end := start + watchlistItemBatchSize
+		if end > len(operations) {
+			end = len(operations)
+		}
+
+		if err := applyWatchlistItemOperations(ctx, client, id, operations[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyWatchlistItemOperations(ctx context.Context, client *securityinsight.WatchlistItemsClient, id parse.WatchlistId, operations []watchlistItemOperation) error {
+	queue := make(chan watchlistItemOperation, len(operations))
+	errors := make(chan error, len(operations))
+	wg := &sync.WaitGroup{}
+
+	for _, operation := range operations {
+		queue <- operation
+	}
+	close(queue)
+
+	for i := 0; i < watchlistItemParallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for operation := range queue {
+				itemId := parse.NewWatchlistItemID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name, operation.name)
+
+				if operation.properties == nil {
+					if _, err := client.Delete(ctx, itemId.ResourceGroup, itemId.WorkspaceName, itemId.WatchlistName, itemId.Name); err != nil {
+						errors <- fmt.Errorf("deleting %s: %+v", itemId, err)
+					}
+					continue
+				}
+
+				params := securityinsight.WatchlistItem{
+					WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+						ItemsKeyValue: operation.properties,
+					},
+				}
+				if _, err := client.CreateOrUpdate(ctx, itemId.ResourceGroup, itemId.WorkspaceName, itemId.WatchlistName, itemId.Name, params); err != nil {
+					errors <- fmt.Errorf("creating/updating %s: %+v", itemId, err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errors)
+
+	if len(errors) > 0 {
+		return <-errors
+	}
+
+	return nil
+}
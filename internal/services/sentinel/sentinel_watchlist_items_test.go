@@ -0,0 +1,231 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestParseWatchlistSource(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Content  string
+		Expected map[string]map[string]interface{}
+		Error    bool
+	}{
+		{
+			Name:     "Header Only",
+			Content:  "Address,Owner\n",
+			Expected: map[string]map[string]interface{}{},
+		},
+		{
+			Name:    "Rows",
+			Content: "Address,Owner\n10.0.0.1,alice\n10.0.0.2,\"bob, carol\"\n",
+			Expected: map[string]map[string]interface{}{
+				"10.0.0.1": {"Address": "10.0.0.1", "Owner": "alice"},
+				"10.0.0.2": {"Address": "10.0.0.2", "Owner": "bob, carol"},
+			},
+		},
+		{
+			Name:    "Byte Order Mark and Padded Header",
+			Content: "\ufeffOwner , Address\r\nalice,10.0.0.1\r\n",
+			Expected: map[string]map[string]interface{}{
+				"10.0.0.1": {"Address": "10.0.0.1", "Owner": "alice"},
+			},
+		},
+		{
+			Name:    "Empty",
+			Content: "",
+			Error:   true,
+		},
+		{
+			Name:    "Missing Search Key Column",
+			Content: "Host,Owner\nvm1,alice\n",
+			Error:   true,
+		},
+		{
+			Name:    "Empty Search Key",
+			Content: "Address,Owner\n,alice\n",
+			Error:   true,
+		},
+		{
+			Name:    "Duplicate Search Key",
+			Content: "Address,Owner\n10.0.0.1,alice\n10.0.0.1,bob\n",
+			Error:   true,
+		},
+		{
+			Name:    "Inconsistent Columns",
+			Content: "Address,Owner\n10.0.0.1\n",
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseWatchlistSource(v.Content, "Address")
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestDiffWatchlistItems(t *testing.T) {
+	item := func(name string, properties map[string]interface{}) securityinsight.WatchlistItem {
+		return securityinsight.WatchlistItem{
+			Name: utils.String(name),
+			WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+				ItemsKeyValue: properties,
+			},
+		}
+	}
+
+	desired := map[string]map[string]interface{}{
+		"10.0.0.1": {"Address": "10.0.0.1", "Owner": "alice"},
+		"10.0.0.2": {"Address": "10.0.0.2", "Owner": "bob"},
+		"10.0.0.3": {"Address": "10.0.0.3", "Owner": "carol"},
+		"10.0.0.4": {"Address": "10.0.0.4", "Owner": "dave"},
+	}
+	existing := []securityinsight.WatchlistItem{
+		// unchanged
+		item("item1", map[string]interface{}{"Address": "10.0.0.1", "Owner": "alice"}),
+		// changed
+		item("item2", map[string]interface{}{"Address": "10.0.0.2", "Owner": "mallory"}),
+		// duplicate of an unchanged item
+		item("item3", map[string]interface{}{"Address": "10.0.0.1", "Owner": "alice"}),
+		// removed
+		item("item4", map[string]interface{}{"Address": "10.0.0.9", "Owner": "eve"}),
+		// missing the search key
+		item("item5", map[string]interface{}{"Owner": "trent"}),
+	}
+
+	operations := diffWatchlistItems(desired, existing, "Address")
+
+	upserts := make(map[string]map[string]interface{})
+	deletes := make([]string, 0)
+	created := make([]string, 0)
+	for _, operation := range operations {
+		if operation.properties == nil {
+			deletes = append(deletes, operation.name)
+			continue
+		}
+		if operation.name == "item1" || operation.name == "item2" || operation.name == "item3" {
+			upserts[operation.name] = operation.properties
+			continue
+		}
+		created = append(created, operation.properties["Address"].(string))
+	}
+
+	if expected := map[string]map[string]interface{}{"item2": desired["10.0.0.2"]}; !reflect.DeepEqual(upserts, expected) {
+		t.Fatalf("expected the updates %+v but got %+v", expected, upserts)
+	}
+	if expected := []string{"item3", "item4", "item5"}; !reflect.DeepEqual(deletes, expected) {
+		t.Fatalf("expected the deletes %+v but got %+v", expected, deletes)
+	}
+	if expected := []string{"10.0.0.3", "10.0.0.4"}; !reflect.DeepEqual(created, expected) {
+		t.Fatalf("expected the creates %+v but got %+v", expected, created)
+	}
+
+	if operations := diffWatchlistItems(map[string]map[string]interface{}{}, []securityinsight.WatchlistItem{}, "Address"); len(operations) != 0 {
+		t.Fatalf("expected no operations but got %+v", operations)
+	}
+}
+
+func TestWatchlistUpdateRetainsStateWhenSyncFails(t *testing.T) {
+	// the Watchlist Items client fails every request after the first batch of Watchlist Items
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"value": []}`))
+			return
+		}
+
+		if atomic.AddInt64(&requests, 1) > watchlistItemBatchSize {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": "BadRequest", "message": "failing the second batch"}}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	itemsClient := securityinsight.NewWatchlistItemsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	meta := &clients.Client{
+		Sentinel: &client.Client{
+			WatchlistItemsClient: &itemsClient,
+		},
+	}
+
+	wrapper := sdk.NewResourceWrapper(WatchlistResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	id := parse.NewWatchlistID("00000000-0000-0000-0000-000000000000", "example-resources", "example-workspace", "example")
+	config := map[string]interface{}{
+		"name":                       id.Name,
+		"log_analytics_workspace_id": fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s", id.SubscriptionId, id.ResourceGroup, id.WorkspaceName),
+		"display_name":               "Example",
+		"item_search_key":            "Address",
+	}
+
+	priorContent := "Address\n10.0.0.1\n"
+	prior := resource.Data(nil)
+	prior.SetId(id.ID())
+	for k, v := range config {
+		prior.Set(k, v)
+	}
+	prior.Set("source_content", priorContent)
+	prior.Set("source_content_hash", watchlistSourceHash(priorContent))
+	prior.Set("item_count", 1)
+	state := prior.State()
+
+	rows := []string{"Address"}
+	for i := 0; i < watchlistItemBatchSize+50; i++ {
+		rows = append(rows, fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+	config["source_content"] = strings.Join(rows, "\n")
+
+	diff, err := resource.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+
+	actual, diags := resource.Apply(context.TODO(), state, diff, meta)
+	if !diags.HasError() {
+		t.Fatalf("expected an error when the second batch of items fails")
+	}
+	if requests <= watchlistItemBatchSize {
+		t.Fatalf("expected the second batch of items to be attempted but only %d items were", requests)
+	}
+
+	for _, key := range []string{"source_content_hash", "item_count", "source_content"} {
+		if actual.Attributes[key] != state.Attributes[key] {
+			t.Fatalf("expected the prior value of %q to be retained when the items fail to synchronise", key)
+		}
+	}
+}
@@ -19,7 +19,10 @@ import (
 
 type WatchlistResource struct{}
 
-var _ sdk.Resource = WatchlistResource{}
+var (
+	_ sdk.ResourceWithUpdate        = WatchlistResource{}
+	_ sdk.ResourceWithCustomizeDiff = WatchlistResource{}
+)
 
 type WatchlistModel struct {
 	Name                    string   `tfschema:"name"`
@@ -29,6 +32,10 @@ type WatchlistModel struct {
 	Labels                  []string `tfschema:"labels"`
 	DefaultDuration         string   `tfschema:"default_duration"`
 	ItemSearchKey           string   `tfschema:"item_search_key"`
+	SourceContent           string   `tfschema:"source_content"`
+	SourceFile              string   `tfschema:"source_file"`
+	SourceContentHash       string   `tfschema:"source_content_hash"`
+	ItemCount               int      `tfschema:"item_count"`
 }
 
 func (r WatchlistResource) Arguments() map[string]*pluginsdk.Schema {
@@ -78,11 +85,32 @@ func (r WatchlistResource) Arguments() map[string]*pluginsdk.Schema {
 			ForceNew:     true,
 			ValidateFunc: commonValidate.ISO8601Duration,
 		},
+		"source_content": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"source_file"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+		"source_file": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"source_content"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
 	}
 }
 
 func (r WatchlistResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"source_content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"item_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
 }
 
 func (r WatchlistResource) ResourceType() string {
@@ -155,6 +183,11 @@ func (r WatchlistResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if err := r.syncItems(ctx, metadata, id, model); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -179,9 +212,21 @@ func (r WatchlistResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
+			var state WatchlistModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
 			model := WatchlistModel{
 				Name:                    id.Name,
 				LogAnalyticsWorkspaceId: loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+
+				// the items aren't read back since there can be tens of thousands of them, the hash and count
+				// of the source content which was last applied are tracked instead
+				SourceContent:     state.SourceContent,
+				SourceFile:        state.SourceFile,
+				SourceContentHash: state.SourceContentHash,
+				ItemCount:         state.ItemCount,
 			}
 
 			if props := resp.WatchlistProperties; props != nil {
@@ -226,3 +271,115 @@ func (r WatchlistResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r WatchlistResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.WatchlistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WatchlistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("source_content", "source_file", "source_content_hash") {
+				if err := r.syncItems(ctx, metadata, *id, model); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WatchlistResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("source_content") || !rd.NewValueKnown("source_file") || !rd.NewValueKnown("item_search_key") {
+				if err := rd.SetNewComputed("source_content_hash"); err != nil {
+					return err
+				}
+				return rd.SetNewComputed("item_count")
+			}
+
+			content := rd.Get("source_content").(string)
+			file := rd.Get("source_file").(string)
+			if content == "" && file == "" {
+				if rd.Get("source_content_hash").(string) == "" {
+					return nil
+				}
+				if err := rd.SetNew("source_content_hash", ""); err != nil {
+					return err
+				}
+				return rd.SetNew("item_count", 0)
+			}
+
+			// the source content is read and parsed during the plan, so that changes to the `source_file` are
+			// detected and invalid content is surfaced before anything is applied
+			source, err := readWatchlistSource(content, file)
+			if err != nil {
+				return err
+			}
+			items, err := parseWatchlistSource(source, rd.Get("item_search_key").(string))
+			if err != nil {
+				return fmt.Errorf("parsing the source content: %+v", err)
+			}
+
+			if hash := watchlistSourceHash(source); hash != rd.Get("source_content_hash").(string) {
+				if err := rd.SetNew("source_content_hash", hash); err != nil {
+					return err
+				}
+				if err := rd.SetNew("item_count", len(items)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// syncItems upserts/deletes the items within the Watchlist to match the `source_content` or `source_file`.
+// When neither is specified the items within the Watchlist aren't managed by this resource.
+func (r WatchlistResource) syncItems(ctx context.Context, metadata sdk.ResourceMetaData, id parse.WatchlistId, model WatchlistModel) error {
+	client := metadata.Client.Sentinel.WatchlistItemsClient
+
+	if model.SourceContent == "" && model.SourceFile == "" {
+		metadata.ResourceData.Set("source_content_hash", "")
+		metadata.ResourceData.Set("item_count", 0)
+		return nil
+	}
+
+	source, err := readWatchlistSource(model.SourceContent, model.SourceFile)
+	if err != nil {
+		return err
+	}
+	items, err := parseWatchlistSource(source, model.ItemSearchKey)
+	if err != nil {
+		return fmt.Errorf("parsing the source content for %s: %+v", id, err)
+	}
+
+	if err := syncWatchlistItems(ctx, client, id, model.ItemSearchKey, items); err != nil {
+		// the items may only have been partially synchronised, as such the planned `source_content_hash` mustn't be
+		// saved - otherwise the remaining items wouldn't be synchronised during the next apply
+		if metadata.ResourceData.IsNewResource() {
+			metadata.ResourceData.Set("source_content_hash", "")
+			metadata.ResourceData.Set("item_count", 0)
+		} else {
+			metadata.ResourceData.Partial(true)
+		}
+		return fmt.Errorf("updating the items within %s: %+v", id, err)
+	}
+
+	metadata.ResourceData.Set("source_content_hash", watchlistSourceHash(source))
+	metadata.ResourceData.Set("item_count", len(items))
+	return nil
+}
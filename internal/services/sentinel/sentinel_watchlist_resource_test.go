@@ -3,6 +3,7 @@ package sentinel_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -61,6 +62,79 @@ func TestAccWatchlist_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccWatchlist_sourceContent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := WatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.sourceContent(data, "Key,Owner\n10.0.0.1,alice\n10.0.0.2,bob\n"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item_count").HasValue("2"),
+				check.That(data.ResourceName).Key("source_content_hash").Exists(),
+			),
+		},
+		data.ImportStep("source_content", "source_content_hash", "item_count"),
+		{
+			Config: r.sourceContent(data, "Key,Owner\n10.0.0.1,carol\n10.0.0.3,dave\n10.0.0.4,eve\n"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item_count").HasValue("3"),
+			),
+		},
+		data.ImportStep("source_content", "source_content_hash", "item_count"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item_count").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWatchlist_sourceFile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := WatchlistResource{}
+
+	sourceFile, err := os.CreateTemp("", "watchlist-*.csv")
+	if err != nil {
+		t.Fatalf("creating source file: %+v", err)
+	}
+	defer os.Remove(sourceFile.Name())
+
+	writeSource := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(sourceFile.Name(), []byte(content), 0600); err != nil {
+				t.Fatalf("writing source file: %+v", err)
+			}
+		}
+	}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			PreConfig: writeSource("Key,Owner\n10.0.0.1,alice\n10.0.0.2,bob\n"),
+			Config:    r.sourceFile(data, sourceFile.Name()),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item_count").HasValue("2"),
+			),
+		},
+		data.ImportStep("source_file", "source_content_hash", "item_count"),
+		{
+			PreConfig: writeSource("Key,Owner\n10.0.0.1,alice\n"),
+			Config:    r.sourceFile(data, sourceFile.Name()),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item_count").HasValue("1"),
+			),
+		},
+		data.ImportStep("source_file", "source_content_hash", "item_count"),
+	})
+}
+
 func (r WatchlistResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Sentinel.WatchlistsClient
 
@@ -110,6 +184,36 @@ resource "azurerm_sentinel_watchlist" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r WatchlistResource) sourceContent(data acceptance.TestData, content string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "accTestWL-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "test"
+  item_search_key            = "Key"
+  source_content             = %q
+}
+`, template, data.RandomInteger, content)
+}
+
+func (r WatchlistResource) sourceFile(data acceptance.TestData, path string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "accTestWL-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "test"
+  item_search_key            = "Key"
+  source_file                = %q
+}
+`, template, data.RandomInteger, path)
+}
+
 func (r WatchlistResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -219,7 +219,7 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 			Encryption:       expandServiceBusNamespaceEncryption(d.Get("customer_managed_key").([]interface{})),
 			DisableLocalAuth: utils.Bool(!d.Get("local_auth_enabled").(bool)),
 		},
-		Tags: expandTags(t),
+		Tags: tags.PointerWithDefaults(expandTags(t)),
 	}
 
 	if capacity := d.Get("capacity"); capacity != nil {
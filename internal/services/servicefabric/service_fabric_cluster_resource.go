@@ -609,7 +609,7 @@ func resourceServiceFabricClusterCreateUpdate(d *pluginsdk.ResourceData, meta in
 
 	cluster := servicefabric.Cluster{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		ClusterProperties: &servicefabric.ClusterProperties{
 			AddOnFeatures:                      addOnFeatures,
 			AzureActiveDirectory:               azureActiveDirectory,
@@ -265,7 +265,7 @@ func (k ClusterResource) Create() sdk.ResourceFunc {
 			for k, v := range model.Tags {
 				tagsMap[k] = v.(string)
 			}
-			cluster.Tags = &tagsMap
+			cluster.Tags = tags.PointerWithDefaults(&tagsMap)
 
 			existing, err := clusterClient.Get(ctx, managedClusterId)
 			if err != nil {
@@ -456,7 +456,7 @@ func (k ClusterResource) Update() sdk.ResourceFunc {
 			for k, v := range model.Tags {
 				tagsMap[k] = v.(string)
 			}
-			cluster.Tags = &tagsMap
+			cluster.Tags = tags.PointerWithDefaults(&tagsMap)
 
 			resp, err := clusterClient.CreateOrUpdate(ctx, managedClusterId, cluster)
 			if err != nil {
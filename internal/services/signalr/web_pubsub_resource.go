@@ -233,7 +233,7 @@ func resourceWebPubSubCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 			Name:     utils.String(d.Get("sku").(string)),
 			Capacity: utils.Int32(int32(d.Get("capacity").(int))),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, parameters, id.ResourceGroup, id.WebPubSubName)
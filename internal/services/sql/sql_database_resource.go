@@ -98,7 +98,7 @@ func resourceSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 			CreateMode:    createMode,
 			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if v, ok := d.GetOk("source_database_id"); ok {
@@ -169,7 +169,7 @@ func resourceSqlFailoverGroupCreateUpdate(d *pluginsdk.ResourceData, meta interf
 			ReadWriteEndpoint: expandSqlFailoverGroupReadWritePolicy(d),
 			PartnerServers:    expandSqlFailoverGroupPartnerServers(d),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(t),
 	}
 
 	if r, ok := d.Get("databases").(*pluginsdk.Set); ok && r.Len() > 0 {
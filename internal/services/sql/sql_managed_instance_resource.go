@@ -232,7 +232,7 @@ func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}
 	parameters := sql.ManagedInstance{
 		Sku:      sku,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		ManagedInstanceProperties: &sql.ManagedInstanceProperties{
 			LicenseType:                sql.ManagedInstanceLicenseType(d.Get("license_type").(string)),
 			AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
@@ -215,7 +215,7 @@ func resourceSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	adminUsername := d.Get("administrator_login").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	tags := tags.Expand(d.Get("tags").(map[string]interface{}))
+	tags := tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 	version := d.Get("version").(string)
 
 	parameters := sql.Server{
@@ -40,6 +40,7 @@ type Client struct {
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
 
+	dataPlaneSender           autorest.Sender
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
 }
@@ -99,6 +100,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
 
+		dataPlaneSender:           options.Sender(),
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
 	}
 
@@ -113,6 +115,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 	if client.storageAdAuth != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
 		accountsClient.Client.Authorizer = *client.storageAdAuth
+		accountsClient.Client.Sender = client.dataPlaneSender
 		return &accountsClient, nil
 	}
 
@@ -128,6 +131,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 
 	accountsClient := accounts.NewWithEnvironment(client.Environment)
 	accountsClient.Client.Authorizer = storageAuth
+	accountsClient.Client.Sender = client.dataPlaneSender
 	return &accountsClient, nil
 }
 
@@ -135,6 +139,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 	if client.storageAdAuth != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
 		blobsClient.Client.Authorizer = *client.storageAdAuth
+		blobsClient.Client.Sender = client.dataPlaneSender
 		return &blobsClient, nil
 	}
 
@@ -150,6 +155,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 
 	blobsClient := blobs.NewWithEnvironment(client.Environment)
 	blobsClient.Client.Authorizer = storageAuth
+	blobsClient.Client.Sender = client.dataPlaneSender
 	return &blobsClient, nil
 }
 
@@ -157,6 +163,7 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
 		containersClient.Client.Authorizer = *client.storageAdAuth
+		containersClient.Client.Sender = client.dataPlaneSender
 		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 		return shim, nil
 	}
@@ -173,6 +180,7 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 	containersClient := containers.NewWithEnvironment(client.Environment)
 	containersClient.Client.Authorizer = storageAuth
+	containersClient.Client.Sender = client.dataPlaneSender
 
 	shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 	return shim, nil
@@ -193,6 +201,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 	directoriesClient := directories.NewWithEnvironment(client.Environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	directoriesClient.Client.Sender = client.dataPlaneSender
 	return &directoriesClient, nil
 }
 
@@ -211,6 +220,7 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 
 	filesClient := files.NewWithEnvironment(client.Environment)
 	filesClient.Client.Authorizer = storageAuth
+	filesClient.Client.Sender = client.dataPlaneSender
 	return &filesClient, nil
 }
 
@@ -229,6 +239,7 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 
 	sharesClient := shares.NewWithEnvironment(client.Environment)
 	sharesClient.Client.Authorizer = storageAuth
+	sharesClient.Client.Sender = client.dataPlaneSender
 	shim := shim.NewDataPlaneStorageShareWrapper(&sharesClient)
 	return shim, nil
 }
@@ -237,6 +248,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 	if client.storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
 		queueClient.Client.Authorizer = *client.storageAdAuth
+		queueClient.Client.Sender = client.dataPlaneSender
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -252,6 +264,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 
 	queuesClient := queues.NewWithEnvironment(client.Environment)
 	queuesClient.Client.Authorizer = storageAuth
+	queuesClient.Client.Sender = client.dataPlaneSender
 	return shim.NewDataPlaneStorageQueueWrapper(&queuesClient), nil
 }
 
@@ -270,6 +283,7 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 
 	entitiesClient := entities.NewWithEnvironment(client.Environment)
 	entitiesClient.Client.Authorizer = storageAuth
+	entitiesClient.Client.Sender = client.dataPlaneSender
 	return &entitiesClient, nil
 }
 
@@ -288,6 +302,7 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 
 	tablesClient := tables.NewWithEnvironment(client.Environment)
 	tablesClient.Client.Authorizer = storageAuth
+	tablesClient.Client.Sender = client.dataPlaneSender
 	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
 	return shim, nil
 }
@@ -100,7 +100,7 @@ func (r ClusterResource) Create() sdk.ResourceFunc {
 					Name:     streamanalytics.ClusterSkuNameDefault,
 					Capacity: utils.Int32(model.StreamingCapacity),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandWithDefaults(model.Tags),
 			}
 
 			future, err := client.CreateOrUpdate(ctx, props, id.ResourceGroup, id.Name, "", "")
@@ -188,12 +188,12 @@ func (r ClusterResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChanges("tags", "tags_all") {
 				props := streamanalytics.Cluster{
 					Sku: &streamanalytics.ClusterSku{
 						Capacity: utils.Int32(state.StreamingCapacity),
 					},
-					Tags: tags.Expand(state.Tags),
+					Tags: tags.ExpandWithDefaults(state.Tags),
 				}
 
 				future, err := client.Update(ctx, props, id.ResourceGroup, id.Name, "")
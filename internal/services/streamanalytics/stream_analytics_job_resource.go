@@ -224,7 +224,7 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			JobType:                            streamanalytics.JobType(jobType),
 		},
 		Identity: expandedIdentity,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 	}
 
 	if jobType == string(streamanalytics.JobTypeEdge) {
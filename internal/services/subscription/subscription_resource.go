@@ -224,9 +224,9 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("failed waiting for Subscription %q (Alias %q) to enter %q state: %+v", *alias.Properties.SubscriptionID, id.Name, "Active", err)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClientForSubscription(*alias.Properties.SubscriptionID)
-		t := tags.Expand(d.Get("tags").(map[string]interface{}))
+		t := tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 		scope := fmt.Sprintf("subscriptions/%s", *alias.Properties.SubscriptionID)
 		tagsResource := resources.TagsResource{
 			Properties: &resources.Tags{
@@ -278,9 +278,9 @@ func resourceSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClientForSubscription(*subscriptionId)
-		t := tags.Expand(d.Get("tags").(map[string]interface{}))
+		t := tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{}))
 		scope := fmt.Sprintf("subscriptions/%s", *subscriptionId)
 		tagsResource := resources.TagsResource{
 			Properties: &resources.Tags{
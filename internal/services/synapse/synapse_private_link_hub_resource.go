@@ -75,7 +75,7 @@ func resourceSynapsePrivateLinkHubCreate(d *pluginsdk.ResourceData, meta interfa
 
 	privateLinkHubInfo := synapse.PrivateLinkHub{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 
 	_, err = client.CreateOrUpdate(ctx, privateLinkHubInfo, id.ResourceGroup, id.Name)
@@ -125,9 +125,9 @@ func resourceSynapsePrivateLinkHubUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		privateLinkHubPatchInfo := synapse.PrivateLinkHubPatchInfo{
-			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+			Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 		}
 
 		_, err := client.Update(ctx, privateLinkHubPatchInfo, id.ResourceGroup, id.Name)
@@ -257,7 +257,7 @@ func resourceSynapseSparkPoolCreate(d *pluginsdk.ResourceData, meta interface{})
 			SparkEventsFolder:           utils.String(d.Get("spark_events_folder").(string)),
 			SparkVersion:                utils.String(d.Get("spark_version").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 	if !*autoScale.Enabled {
 		bigDataPoolInfo.NodeCount = utils.Int32(int32(d.Get("node_count").(int)))
@@ -367,7 +367,7 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 			SparkEventsFolder:           utils.String(d.Get("spark_events_folder").(string)),
 			SparkVersion:                utils.String(d.Get("spark_version").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(d.Get("tags").(map[string]interface{})),
 	}
 	if !*autoScale.Enabled {
 		bigDataPoolInfo.NodeCount = utils.Int32(int32(d.Get("node_count").(int)))
@@ -286,7 +286,7 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 					},
 					ZoneRedundant: utils.Bool(model.ZoneRedundant),
 				},
-				Tags: tags.FromTypedObject(model.Tags),
+				Tags: tags.WithDefaults(tags.FromTypedObject(model.Tags)),
 			}
 
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.HostingEnvironmentName, envelope)
@@ -454,8 +454,8 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				existing.AppServiceEnvironment.ClusterSettings = expandClusterSettingsModel(state.ClusterSetting)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.FromTypedObject(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.WithDefaults(tags.FromTypedObject(state.Tags))
 			}
 
 			aseNetworkConfig := web.AseV3NetworkingConfiguration{
@@ -181,7 +181,7 @@ func resourceAppServicePlanCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Location:                 &location,
 		Kind:                     &kind,
 		Sku:                      &sku,
-		Tags:                     tags.Expand(t),
+		Tags:                     tags.ExpandWithDefaults(t),
 		AppServicePlanProperties: properties,
 	}
 
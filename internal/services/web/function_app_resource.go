@@ -312,7 +312,7 @@ func resourceFunctionAppCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:         utils.String(appServicePlanID),
 			Enabled:              utils.Bool(enabled),
@@ -445,7 +445,7 @@ func resourceFunctionAppUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:         utils.String(appServicePlanID),
 			Enabled:              utils.Bool(enabled),
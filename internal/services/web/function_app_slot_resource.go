@@ -272,7 +272,7 @@ func resourceFunctionAppSlotCreate(d *pluginsdk.ResourceData, meta interface{})
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:         utils.String(appServicePlanID),
 			Enabled:              utils.Bool(enabled),
@@ -368,7 +368,7 @@ func resourceFunctionAppSlotUpdate(d *pluginsdk.ResourceData, meta interface{})
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:         utils.String(appServicePlanID),
 			Enabled:              utils.Bool(enabled),
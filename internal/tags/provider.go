@@ -0,0 +1,360 @@
+package tags
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// providerConfig is the `default_tags` and `ignore_tags` configuration from the Provider block
+type providerConfig struct {
+	defaultTags       map[string]string
+	ignoreKeys        []string
+	ignoreKeyPrefixes []string
+}
+
+var (
+	providerConfigLock sync.RWMutex
+	config             providerConfig
+)
+
+// Configure sets the default tags which are merged into the tags sent for every resource, alongside the keys
+// (and prefixes of keys) for tags which are ignored when reading the tags for every resource
+func Configure(defaultTags map[string]string, ignoreKeys []string, ignoreKeyPrefixes []string) {
+	providerConfigLock.Lock()
+	defer providerConfigLock.Unlock()
+
+	config = providerConfig{
+		defaultTags:       defaultTags,
+		ignoreKeys:        ignoreKeys,
+		ignoreKeyPrefixes: ignoreKeyPrefixes,
+	}
+}
+
+func currentConfig() providerConfig {
+	providerConfigLock.RLock()
+	defer providerConfigLock.RUnlock()
+
+	return config
+}
+
+// enabled returns whether either `default_tags` or `ignore_tags` are configured in the Provider block, when
+// neither are configured the tags for each resource are left untouched and `tags_all` isn't populated
+func (c providerConfig) enabled() bool {
+	return len(c.defaultTags) > 0 || len(c.ignoreKeys) > 0 || len(c.ignoreKeyPrefixes) > 0
+}
+
+// isIgnored returns whether the tag is ignored, since tag keys are case-insensitive this is compared case-insensitively
+func (c providerConfig) isIgnored(key string) bool {
+	for _, v := range c.ignoreKeys {
+		if strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range c.ignoreKeyPrefixes {
+		if len(key) >= len(v) && strings.EqualFold(key[:len(v)], v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDefault returns whether the tag matches one of the default tags configured in the Provider block
+func (c providerConfig) isDefault(key, value string) bool {
+	for k, v := range c.defaultTags {
+		if strings.EqualFold(key, k) && value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withDefaults returns the default tags merged with the tags specified, where the tags specified take precedence
+func (c providerConfig) withDefaults(input map[string]string) map[string]string {
+	output := make(map[string]string, len(c.defaultTags)+len(input))
+
+	for k, v := range c.defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range input {
+		// remove any default tag with the same key using a different casing
+		for existing := range output {
+			if strings.EqualFold(existing, k) {
+				delete(output, existing)
+			}
+		}
+		output[k] = v
+	}
+
+	return output
+}
+
+// WithDefaults returns the tags which should be sent in the payload for an ARM Resource, which are the tags
+// specified for the resource merged with any `default_tags` configured in the Provider block.
+//
+// This should only be used for the tags on the ARM Resource itself, since `tags_all` assumes these are sent
+func WithDefaults(input map[string]*string) map[string]*string {
+	c := currentConfig()
+	if len(c.defaultTags) == 0 {
+		return input
+	}
+
+	merged := c.withDefaults(ToTypedObject(input))
+	output := make(map[string]*string, len(merged))
+	for k, v := range merged {
+		value := v
+		output[k] = &value
+	}
+	return output
+}
+
+// ExpandWithDefaults expands the `tags` specified for an ARM Resource into the tags which should be sent in the payload
+func ExpandWithDefaults(input map[string]interface{}) map[string]*string {
+	return WithDefaults(Expand(input))
+}
+
+// PointerWithDefaults is WithDefaults for SDKs which represent the tags for an ARM Resource as a `*map[string]string`
+func PointerWithDefaults(input *map[string]string) *map[string]string {
+	c := currentConfig()
+	if len(c.defaultTags) == 0 {
+		return input
+	}
+
+	tags := make(map[string]string)
+	if input != nil {
+		tags = *input
+	}
+	output := c.withDefaults(tags)
+	return &output
+}
+
+// nonResourceTags are the Resources where the `tags` field isn't the tags for the ARM Resource - for example the
+// metadata for a DNS Record, the labels on an App Configuration Key, the tags within a Log Analytics Saved Search
+// or the tags on a Key Vault Secret (which are managed through the data plane) - as such `default_tags` and
+// `ignore_tags` aren't applied to these
+var nonResourceTags = map[string]struct{}{
+	"azurerm_app_configuration_feature":                              {},
+	"azurerm_app_configuration_key":                                  {},
+	"azurerm_dns_a_record":                                           {},
+	"azurerm_dns_aaaa_record":                                        {},
+	"azurerm_dns_caa_record":                                         {},
+	"azurerm_dns_cname_record":                                       {},
+	"azurerm_dns_mx_record":                                          {},
+	"azurerm_dns_ns_record":                                          {},
+	"azurerm_dns_ptr_record":                                         {},
+	"azurerm_dns_srv_record":                                         {},
+	"azurerm_dns_txt_record":                                         {},
+	"azurerm_key_vault_certificate":                                  {},
+	"azurerm_key_vault_key":                                          {},
+	"azurerm_key_vault_managed_storage_account":                      {},
+	"azurerm_key_vault_managed_storage_account_sas_token_definition": {},
+	"azurerm_key_vault_secret":                                       {},
+	"azurerm_log_analytics_saved_search":                             {},
+	"azurerm_private_dns_a_record":                                   {},
+	"azurerm_private_dns_aaaa_record":                                {},
+	"azurerm_private_dns_cname_record":                               {},
+	"azurerm_private_dns_mx_record":                                  {},
+	"azurerm_private_dns_ptr_record":                                 {},
+	"azurerm_private_dns_srv_record":                                 {},
+	"azurerm_private_dns_txt_record":                                 {},
+}
+
+// ConfigureResource exposes the `tags_all` attribute on the Resource, containing all of the tags assigned to the
+// resource (including the default tags from the Provider block) - and ensures that `tags` only contains the tags
+// specified in the configuration, by removing the default and ignored tags after the resource has been read.
+//
+// When neither `default_tags` nor `ignore_tags` are configured in the Provider block this is a no-op, meaning
+// that `tags_all` isn't populated and no diff is introduced for existing resources.
+func ConfigureResource(name string, resource *pluginsdk.Resource) {
+	if _, ok := nonResourceTags[name]; ok {
+		return
+	}
+
+	tagsSchema, ok := resource.Schema["tags"]
+	if !ok || tagsSchema.Type != pluginsdk.TypeMap || !tagsSchema.Optional {
+		return
+	}
+	if _, ok := resource.Schema["tags_all"]; ok {
+		return
+	}
+
+	resource.Schema["tags_all"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	resource.Create = wrapTagsFunc(resource.Create) //nolint:staticcheck
+	resource.CreateContext = wrapTagsContextFunc(resource.CreateContext)
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	resource.Read = wrapTagsFunc(resource.Read) //nolint:staticcheck
+	resource.ReadContext = wrapTagsContextFunc(resource.ReadContext)
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	resource.Update = wrapTagsFunc(resource.Update) //nolint:staticcheck
+	resource.UpdateContext = wrapTagsContextFunc(resource.UpdateContext)
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	diff := customizeDiffTagsAll(resource.Update != nil || resource.UpdateContext != nil) //nolint:staticcheck
+	if resource.CustomizeDiff == nil {
+		resource.CustomizeDiff = diff
+	} else {
+		resource.CustomizeDiff = customdiff.Sequence(resource.CustomizeDiff, diff)
+	}
+}
+
+// ConfigureDataSource omits the tags ignored in the Provider block from the `tags` exposed by the Data Source
+func ConfigureDataSource(dataSource *pluginsdk.Resource) {
+	tagsSchema, ok := dataSource.Schema["tags"]
+	if !ok || tagsSchema.Type != pluginsdk.TypeMap || !tagsSchema.Computed {
+		return
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if read := dataSource.Read; read != nil { //nolint:staticcheck
+		dataSource.Read = func(d *pluginsdk.ResourceData, meta interface{}) error { //nolint:staticcheck
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return setIgnoredTags(d)
+		}
+	}
+	if read := dataSource.ReadContext; read != nil {
+		dataSource.ReadContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			if diags := read(ctx, d, meta); diags.HasError() {
+				return diags
+			}
+			return diag.FromErr(setIgnoredTags(d))
+		}
+	}
+}
+
+func wrapTagsFunc(f func(d *pluginsdk.ResourceData, meta interface{}) error) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		configured, _ := d.Get("tags").(map[string]interface{})
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return setTagsAfterRead(d, configured)
+	}
+}
+
+func wrapTagsContextFunc(f func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		configured, _ := d.Get("tags").(map[string]interface{})
+		if diags := f(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+		return diag.FromErr(setTagsAfterRead(d, configured))
+	}
+}
+
+// setTagsAfterRead splits the tags read from Azure into `tags_all` (all of the tags assigned to the resource, other
+// than those which are ignored) and `tags` (only the tags specified for the resource) - where `previous` is the value
+// of `tags` prior to the resource being read, which is either the configuration or the prior state
+func setTagsAfterRead(d *pluginsdk.ResourceData, previous map[string]interface{}) error {
+	c := currentConfig()
+	if !c.enabled() {
+		// clear `tags_all` should `default_tags`/`ignore_tags` have previously been configured
+		if existing, _ := d.Get("tags_all").(map[string]interface{}); len(existing) > 0 {
+			return d.Set("tags_all", nil)
+		}
+		return nil
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	read, _ := d.Get("tags").(map[string]interface{})
+	all := make(map[string]interface{}, len(read))
+	specified := make(map[string]interface{}, len(read))
+	for k, v := range read {
+		if c.isIgnored(k) {
+			continue
+		}
+		all[k] = v
+
+		// a default tag is only retained in `tags` when it's also been specified for the resource
+		if value, _ := v.(string); c.isDefault(k, value) && previous[k] != v {
+			continue
+		}
+		specified[k] = v
+	}
+
+	if err := d.Set("tags", specified); err != nil {
+		return err
+	}
+	return d.Set("tags_all", all)
+}
+
+func setIgnoredTags(d *pluginsdk.ResourceData) error {
+	c := currentConfig()
+	if len(c.ignoreKeys) == 0 && len(c.ignoreKeyPrefixes) == 0 {
+		return nil
+	}
+
+	read, _ := d.Get("tags").(map[string]interface{})
+	output := make(map[string]interface{}, len(read))
+	for k, v := range read {
+		if !c.isIgnored(k) {
+			output[k] = v
+		}
+	}
+	return d.Set("tags", output)
+}
+
+// customizeDiffTagsAll computes the new value for `tags_all` when `default_tags` or `ignore_tags` are configured in the
+// Provider block - otherwise (or when the resource can't be updated) `tags_all` is only ever populated by Read
+func customizeDiffTagsAll(updatable bool) pluginsdk.CustomizeDiffFunc {
+	return func(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+		c := currentConfig()
+		if !c.enabled() || !updatable {
+			// `tags_all` won't be present in the state for existing resources, which would otherwise be planned as computed
+			return diff.Clear("tags_all")
+		}
+
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+
+		raw, _ := diff.Get("tags").(map[string]interface{})
+		tags := make(map[string]string, len(raw))
+		for k, v := range raw {
+			value, _ := TagValueToString(v)
+			tags[k] = value
+		}
+
+		expected := make(map[string]interface{})
+		for k, v := range c.withDefaults(tags) {
+			if !c.isIgnored(k) {
+				expected[k] = v
+			}
+		}
+
+		existing, _ := diff.Get("tags_all").(map[string]interface{})
+		if reflect.DeepEqual(existing, expected) {
+			return nil
+		}
+
+		return diff.SetNew("tags_all", expected)
+	}
+}
@@ -0,0 +1,207 @@
+package tags
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func configureForTest(t *testing.T, defaultTags map[string]string, ignoreKeys []string, ignoreKeyPrefixes []string) {
+	Configure(defaultTags, ignoreKeys, ignoreKeyPrefixes)
+	t.Cleanup(func() {
+		Configure(nil, nil, nil)
+	})
+}
+
+// testResource returns a resource whose Read sets `tags` to the tags assigned to the remote resource
+func testResource(remote map[string]*string) *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"tags": Schema(),
+		},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return FlattenAndSet(d, remote)
+		},
+		Update: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	input := map[string]*string{
+		"Owner": utils.String("networking"),
+		"name":  utils.String("example"),
+	}
+
+	if actual := WithDefaults(input); !reflect.DeepEqual(actual, input) {
+		t.Fatalf("expected the tags to be unchanged without default tags but got %+v", actual)
+	}
+
+	configureForTest(t, map[string]string{
+		"environment": "production",
+		"owner":       "platform",
+	}, nil, nil)
+
+	expected := map[string]*string{
+		"environment": utils.String("production"),
+		"Owner":       utils.String("networking"),
+		"name":        utils.String("example"),
+	}
+	if actual := WithDefaults(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	pointer := PointerWithDefaults(nil)
+	if pointer == nil || !reflect.DeepEqual(*pointer, map[string]string{"environment": "production", "owner": "platform"}) {
+		t.Fatalf("expected the default tags to be returned but got %+v", pointer)
+	}
+
+	// the tags specified for the resource are never modified
+	if len(Expand(map[string]interface{}{"name": "example"})) != 1 {
+		t.Fatalf("expected Expand not to include the default tags")
+	}
+}
+
+func TestConfigureResourceWithoutProviderTags(t *testing.T) {
+	resource := testResource(map[string]*string{
+		"name": utils.String("example"),
+	})
+	ConfigureResource("azurerm_example", resource)
+
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("expected `tags_all` to be added to the schema")
+	}
+
+	d := resource.TestResourceData()
+	d.SetId("example")
+	d.Set("name", "example")
+	if err := resource.Read(d, nil); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); len(actual) != 0 {
+		t.Fatalf("expected `tags_all` not to be populated but got %+v", actual)
+	}
+
+	diff, err := resource.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"name": "example",
+		},
+	}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no diff but got %+v", diff.Attributes["tags_all.%"])
+	}
+}
+
+func TestConfigureResource(t *testing.T) {
+	configureForTest(t, map[string]string{
+		"environment": "production",
+	}, []string{"ignored"}, nil)
+
+	// the default tags are assigned to the resource, but aren't specified in the config
+	resource := testResource(map[string]*string{
+		"environment": utils.String("production"),
+		"name":        utils.String("example"),
+		"ignored":     utils.String("true"),
+	})
+	ConfigureResource("azurerm_example", resource)
+
+	d := resource.TestResourceData()
+	d.SetId("example")
+	d.Set("name", "example")
+	d.Set("tags", map[string]interface{}{
+		"name": "example",
+	})
+	if err := resource.Read(d, nil); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, map[string]interface{}{"name": "example"}) {
+		t.Fatalf("expected `tags` to only contain the tags specified but got %+v", actual)
+	}
+	expected := map[string]interface{}{
+		"environment": "production",
+		"name":        "example",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expected, actual)
+	}
+
+	diff, err := resource.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"name": "example",
+		},
+	}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no diff for the default tags but got %+v", diff.Attributes)
+	}
+
+	// whereas changing a tag is reflected in `tags_all`
+	diff, err = resource.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"name": "updated",
+		},
+	}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if diff == nil || diff.Attributes["tags_all.name"] == nil || diff.Attributes["tags_all.name"].New != "updated" {
+		t.Fatalf("expected `tags_all.name` to be updated but got %+v", diff)
+	}
+	if diff.Attributes["tags.environment"] != nil {
+		t.Fatalf("expected no diff for the default tag but got %+v", diff.Attributes["tags.environment"])
+	}
+}
+
+func TestConfigureResourceDefaultTagSpecified(t *testing.T) {
+	configureForTest(t, map[string]string{
+		"environment": "production",
+	}, nil, nil)
+
+	resource := testResource(map[string]*string{
+		"environment": utils.String("production"),
+	})
+	ConfigureResource("azurerm_example", resource)
+
+	d := resource.TestResourceData()
+	d.SetId("example")
+	d.Set("name", "example")
+	d.Set("tags", map[string]interface{}{
+		"environment": "production",
+	})
+	if err := resource.Read(d, nil); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	// a default tag which is also specified for the resource is retained in `tags`
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, map[string]interface{}{"environment": "production"}) {
+		t.Fatalf("expected `tags` to contain the default tag but got %+v", actual)
+	}
+}
+
+func TestConfigureResourceNonResourceTags(t *testing.T) {
+	resource := testResource(nil)
+	ConfigureResource("azurerm_app_configuration_key", resource)
+
+	if _, ok := resource.Schema["tags_all"]; ok {
+		t.Fatalf("expected `tags_all` not to be added to the schema")
+	}
+}
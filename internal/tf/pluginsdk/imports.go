@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type IDValidationFunc func(id string) error
 
+// importerIDValidationFuncs contains the IDValidationFunc used by each Importer, which allows tooling
+// to determine which Resource a given Resource ID belongs to
+var importerIDValidationFuncs sync.Map
+
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
 // ImporterValidatingResourceId validates the ID provided at import time is valid
@@ -24,7 +29,7 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -35,4 +40,20 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			return thenFunc(ctx, d, meta)
 		},
 	}
+	importerIDValidationFuncs.Store(importer, validateFunc)
+	return importer
+}
+
+// ImporterIDValidationFunc returns the IDValidationFunc used by an Importer created using
+// ImporterValidatingResourceId or ImporterValidatingResourceIdThen
+func ImporterIDValidationFunc(importer *schema.ResourceImporter) (IDValidationFunc, bool) {
+	if importer == nil {
+		return nil, false
+	}
+
+	v, ok := importerIDValidationFuncs.Load(importer)
+	if !ok {
+		return nil, false
+	}
+	return v.(IDValidationFunc), true
 }
@@ -3,6 +3,7 @@ package pluginsdk
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,3 +25,35 @@ func ValueChangeConditionShim(shimFunc ValueChangeConditionFunc) customdiff.Valu
 		return shimFunc(ctx, old, new, meta)
 	}
 }
+
+// ErrorDiagnosticsFunc converts an error returned from a Resource/Data Source into Diagnostics
+type ErrorDiagnosticsFunc func(err error, meta interface{}) diag.Diagnostics
+
+// DiagnosticsShim is a shim around the (non-context aware) CRUD functions for a Resource/Data Source
+// which converts any errors returned into Diagnostics using errorFunc, rather than using the error
+// message as both the Summary and Detail of the Diagnostic
+func DiagnosticsShim(resource *schema.Resource, errorFunc ErrorDiagnosticsFunc) {
+	wrap := func(f func(d *schema.ResourceData, meta interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return errorFunc(f(d, meta), meta)
+		}
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if createFunc := resource.Create; createFunc != nil { //nolint:staticcheck
+		resource.Create = nil //nolint:staticcheck
+		resource.CreateContext = wrap(createFunc)
+	}
+	if readFunc := resource.Read; readFunc != nil { //nolint:staticcheck
+		resource.Read = nil //nolint:staticcheck
+		resource.ReadContext = wrap(readFunc)
+	}
+	if updateFunc := resource.Update; updateFunc != nil { //nolint:staticcheck
+		resource.Update = nil //nolint:staticcheck
+		resource.UpdateContext = wrap(updateFunc)
+	}
+	if deleteFunc := resource.Delete; deleteFunc != nil { //nolint:staticcheck
+		resource.Delete = nil //nolint:staticcheck
+		resource.DeleteContext = wrap(deleteFunc)
+	}
+}
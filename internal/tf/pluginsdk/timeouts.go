@@ -1,6 +1,7 @@
 package pluginsdk
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,3 +20,38 @@ const (
 	TimeoutDelete  = schema.TimeoutDelete
 	TimeoutDefault = schema.TimeoutDefault
 )
+
+// ApplyDefaultTimeouts overrides the default timeouts for the Resource with the (non-zero) durations specified,
+// returning an error if the Resource doesn't support the operation. Any timeouts specified within a `timeouts`
+// block on an instance of the Resource continue to take precedence over these defaults.
+func ApplyDefaultTimeouts(resource *Resource, create, read, update, delete time.Duration) error {
+	if resource.Timeouts == nil {
+		return fmt.Errorf("the resource doesn't support timeouts")
+	}
+
+	// copy the timeouts so that these defaults are scoped to this instance of the Resource
+	timeouts := *resource.Timeouts
+	overrides := []struct {
+		operation string
+		existing  **time.Duration
+		value     time.Duration
+	}{
+		{operation: TimeoutCreate, existing: &timeouts.Create, value: create},
+		{operation: TimeoutRead, existing: &timeouts.Read, value: read},
+		{operation: TimeoutUpdate, existing: &timeouts.Update, value: update},
+		{operation: TimeoutDelete, existing: &timeouts.Delete, value: delete},
+	}
+	for _, v := range overrides {
+		if v.value == 0 {
+			continue
+		}
+		if *v.existing == nil {
+			return fmt.Errorf("the resource doesn't support a %q timeout", v.operation)
+		}
+		value := v.value
+		*v.existing = &value
+	}
+
+	resource.Timeouts = &timeouts
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -39,8 +40,11 @@ func ForDelete(ctx context.Context, d *pluginsdk.ResourceData) (context.Context,
 //
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
+//
+// Responses to GET requests made using this context can be served from the Read Cache (when enabled) - unless the
+// resource is being read during an apply
 func ForRead(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutRead))
+	return buildWithTimeout(common.WithCachedReadsOutsideApply(ctx, d), d.Timeout(pluginsdk.TimeoutRead))
 }
 
 // ForUpdate returns the context wrapped with the timeout for an Update operation
@@ -0,0 +1,658 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+// probeResourceId is a Resource ID which doesn't belong to any Resource - Resources which consider this
+// valid accept any Resource ID and as such can't be used to determine the Resource Type for a Resource ID
+const probeResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/export/providers/Microsoft.Export/probes/export"
+
+func main() {
+	f := flag.NewFlagSet("export", flag.ExitOnError)
+
+	scope := f.String("scope", "", "The ID of the Subscription or Resource Group which should be exported")
+	outputPath := f.String("output-path", ".", "The directory into which the `main.tf` and `import.sh` files should be written")
+	recordPath := f.String("record-path", "", "The directory into which the responses from Azure should be recorded")
+	replayPath := f.String("replay-path", "", "The directory containing previously recorded responses, which are used rather than connecting to Azure")
+	verbose := f.Bool("verbose", false, "Output the logs from the Provider?")
+
+	_ = f.Parse(os.Args[1:])
+
+	logger := log.New(os.Stderr, "", 0)
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	if *scope == "" {
+		logger.Print("The ID of the Subscription or Resource Group to export must be specified via `-scope`")
+		os.Exit(1)
+	}
+	if *recordPath != "" && *replayPath != "" {
+		logger.Print("Only one of `-record-path` and `-replay-path` can be specified")
+		os.Exit(1)
+	}
+
+	input := exportInput{
+		scope:      *scope,
+		outputPath: *outputPath,
+		recordPath: *recordPath,
+		replayPath: *replayPath,
+		logger:     logger,
+	}
+	if err := run(context.Background(), input); err != nil {
+		logger.Print(err)
+		os.Exit(1)
+	}
+}
+
+type exportInput struct {
+	scope      string
+	outputPath string
+	recordPath string
+	replayPath string
+	logger     *log.Logger
+}
+
+// candidate is a Resource which the Resource ID for an ARM Resource is valid for
+type candidate struct {
+	resourceType string
+	resource     *schema.Resource
+	deprecated   bool
+	validate     pluginsdk.IDValidationFunc
+}
+
+// exportedResource is an ARM Resource which has been matched to, and read using, a Resource
+type exportedResource struct {
+	id            string
+	resourceType  string
+	name          string
+	resource      *schema.Resource
+	state         *terraform.InstanceState
+	alternateType []string
+}
+
+// failedResource is an ARM Resource which couldn't be exported
+type failedResource struct {
+	id     string
+	reason string
+}
+
+func run(ctx context.Context, input exportInput) error {
+	subscriptionId, resourceGroupName, err := parseScope(input.scope)
+	if err != nil {
+		return err
+	}
+
+	p := provider.AzureProvider()
+	client, err := buildClient(ctx, p, subscriptionId, input.recordPath, input.replayPath)
+	if err != nil {
+		return fmt.Errorf("building client: %+v", err)
+	}
+
+	ids, err := listResourceIds(ctx, client, subscriptionId, resourceGroupName)
+	if err != nil {
+		return fmt.Errorf("listing resources within %q: %+v", input.scope, err)
+	}
+	input.logger.Printf("Found %d resources within %q", len(ids), input.scope)
+
+	candidates := buildCandidates(p.ResourcesMap)
+	names := map[string]int{}
+	exported := make([]exportedResource, 0)
+	failed := make([]failedResource, 0)
+	for _, id := range ids {
+		result, err := exportResource(ctx, client, candidates, id)
+		if err != nil {
+			input.logger.Printf("Unable to export %q: %+v", id, err)
+			failed = append(failed, failedResource{
+				id:     id,
+				reason: err.Error(),
+			})
+			continue
+		}
+
+		result.name = uniqueName(names, result.resourceType, terraformName(id))
+		input.logger.Printf("Exported %q as %s.%s", id, result.resourceType, result.name)
+		exported = append(exported, *result)
+	}
+
+	if err := os.MkdirAll(input.outputPath, 0o755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", input.outputPath, err)
+	}
+
+	configPath := filepath.Join(input.outputPath, "main.tf")
+	if err := os.WriteFile(configPath, []byte(renderConfiguration(exported, failed)), 0o644); err != nil {
+		return fmt.Errorf("writing %q: %+v", configPath, err)
+	}
+
+	importPath := filepath.Join(input.outputPath, "import.sh")
+	if err := os.WriteFile(importPath, []byte(renderImportScript(exported)), 0o755); err != nil {
+		return fmt.Errorf("writing %q: %+v", importPath, err)
+	}
+
+	input.logger.Printf("Exported %d resources (%d could not be exported) into %q", len(exported), len(failed), input.outputPath)
+	return nil
+}
+
+// parseScope returns the Subscription ID and (optionally) the Resource Group Name from the scope to export
+func parseScope(input string) (string, string, error) {
+	if id, err := commonids.ParseResourceGroupIDInsensitively(input); err == nil {
+		return id.SubscriptionId, id.ResourceGroupName, nil
+	}
+
+	if id, err := commonids.ParseSubscriptionIDInsensitively(input); err == nil {
+		return id.SubscriptionId, "", nil
+	}
+
+	return "", "", fmt.Errorf("expected the scope %q to be the ID of a Subscription or a Resource Group", input)
+}
+
+// buildClient configures the Provider using the same Environment Variables as Terraform - unless recorded
+// responses are being replayed, where a client which doesn't authenticate is used instead
+func buildClient(ctx context.Context, p *schema.Provider, subscriptionId, recordPath, replayPath string) (*clients.Client, error) {
+	if replayPath != "" {
+		os.Setenv(common.CassetteModeEnvVar, common.CassetteModeReplay)
+		os.Setenv(common.CassetteDirEnvVar, replayPath)
+		return buildOfflineClient(ctx, subscriptionId)
+	}
+
+	if recordPath != "" {
+		os.Setenv(common.CassetteModeEnvVar, common.CassetteModeRecord)
+		os.Setenv(common.CassetteDirEnvVar, recordPath)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"features":                   []interface{}{map[string]interface{}{}},
+		"subscription_id":            subscriptionId,
+		"cache_read_requests":        true,
+		"skip_provider_registration": true,
+	})
+	if diags := p.Configure(ctx, config); diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+
+	return p.Meta().(*clients.Client), nil
+}
+
+func buildOfflineClient(ctx context.Context, subscriptionId string) (*clients.Client, error) {
+	env := azure.PublicCloud
+	authorizer := autorest.NullAuthorizer{}
+
+	client := &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			Environment:                      env,
+			SkipResourceProviderRegistration: true,
+			SubscriptionId:                   subscriptionId,
+		},
+	}
+
+	o := &common.ClientOptions{
+		SubscriptionId:            subscriptionId,
+		KeyVaultAuthorizer:        authorizer,
+		ResourceManagerAuthorizer: authorizer,
+		ResourceManagerEndpoint:   env.ResourceManagerEndpoint,
+		StorageAuthorizer:         authorizer,
+		SynapseAuthorizer:         authorizer,
+		BatchManagementAuthorizer: authorizer,
+		SkipProviderReg:           true,
+		Environment:               env,
+		Features:                  features.Default(),
+		ReadCache:                 common.NewReadCache(env.ResourceManagerEndpoint),
+		TokenFunc: func(endpoint string) (autorest.Authorizer, error) {
+			return authorizer, nil
+		},
+	}
+	if err := client.Build(ctx, o); err != nil {
+		return nil, fmt.Errorf("building Client: %+v", err)
+	}
+
+	return client, nil
+}
+
+// listResourceIds returns the IDs of the resources within the scope - using the same API as the
+// `azurerm_resources` Data Source, which only returns top-level resources
+func listResourceIds(ctx context.Context, client *clients.Client, subscriptionId, resourceGroupName string) ([]string, error) {
+	ids := make([]string, 0)
+
+	filter := ""
+	if resourceGroupName != "" {
+		ids = append(ids, commonids.NewResourceGroupID(subscriptionId, resourceGroupName).ID())
+		filter = fmt.Sprintf("resourceGroup eq '%s'", resourceGroupName)
+	} else {
+		groups, err := client.Resource.GroupsClient.List(ctx, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("listing Resource Groups: %+v", err)
+		}
+		for groups.NotDone() {
+			for _, v := range groups.Values() {
+				if v.ID != nil {
+					ids = append(ids, *v.ID)
+				}
+			}
+			if err := groups.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("listing Resource Groups: %+v", err)
+			}
+		}
+	}
+
+	// Use List instead of listComplete because of bug in SDK: https://github.com/Azure/azure-sdk-for-go/issues/9510
+	resources, err := client.Resource.ResourcesClient.List(ctx, filter, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resources: %+v", err)
+	}
+	for resources.NotDone() {
+		for _, v := range resources.Values() {
+			if v.ID != nil {
+				ids = append(ids, *v.ID)
+			}
+		}
+		if err := resources.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Resources: %+v", err)
+		}
+	}
+
+	return ids, nil
+}
+
+// buildCandidates returns the Resources whose Importer validates the Resource ID, which are ordered so that
+// Resources which aren't deprecated are tried first
+func buildCandidates(resources map[string]*schema.Resource) []candidate {
+	candidates := make([]candidate, 0)
+	for resourceType, resource := range resources {
+		validate, ok := pluginsdk.ImporterIDValidationFunc(resource.Importer)
+		if !ok {
+			continue
+		}
+
+		if validate(probeResourceId) == nil {
+			continue
+		}
+
+		candidates = append(candidates, candidate{
+			resourceType: resourceType,
+			resource:     resource,
+			deprecated:   resource.DeprecationMessage != "",
+			validate:     validate,
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].deprecated != candidates[j].deprecated {
+			return !candidates[i].deprecated
+		}
+		return candidates[i].resourceType < candidates[j].resourceType
+	})
+
+	return candidates
+}
+
+// matchingCandidates returns the candidates which the Resource ID is valid for
+func matchingCandidates(candidates []candidate, id string) []candidate {
+	output := make([]candidate, 0)
+	for _, v := range candidates {
+		if v.validate(id) == nil {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+// exportResource reads the resource using each of the Resources the Resource ID is valid for, in turn,
+// until one is successful - since multiple Resources can share the same Resource ID format (for example
+// Linux and Windows Virtual Machines), where Resources error when reading a resource of the other kind
+func exportResource(ctx context.Context, meta interface{}, candidates []candidate, id string) (*exportedResource, error) {
+	matches := matchingCandidates(candidates, id)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no Resource supports importing this Resource ID")
+	}
+
+	failures := make([]string, 0)
+	for i, v := range matches {
+		state, err := readResource(ctx, v.resource, id, meta)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %+v", v.resourceType, err))
+			continue
+		}
+		if state == nil {
+			failures = append(failures, fmt.Sprintf("%s: the resource was not found", v.resourceType))
+			continue
+		}
+
+		alternateTypes := make([]string, 0)
+		for _, alternate := range matches[i+1:] {
+			alternateTypes = append(alternateTypes, alternate.resourceType)
+		}
+
+		return &exportedResource{
+			id:            id,
+			resourceType:  v.resourceType,
+			resource:      v.resource,
+			state:         state,
+			alternateType: alternateTypes,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("reading the resource:\n\n%s", strings.Join(failures, "\n"))
+}
+
+// readResource imports and then reads the resource in the same way as `terraform import`, returning nil
+// when the resource doesn't exist
+func readResource(ctx context.Context, resource *schema.Resource, id string, meta interface{}) (state *terraform.InstanceState, err error) {
+	// a Resource which doesn't support this kind of resource may fail unexpectedly, which shouldn't
+	// prevent the other resources from being exported
+	defer func() {
+		if r := recover(); r != nil {
+			state = nil
+			err = fmt.Errorf("panic: %+v", r)
+		}
+	}()
+
+	state = &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id": id,
+		},
+	}
+
+	imported, err := resource.Importer.StateContext(ctx, resource.Data(state), meta)
+	if err != nil {
+		return nil, fmt.Errorf("importing: %+v", err)
+	}
+	if len(imported) == 0 || imported[0].State() == nil {
+		return nil, nil
+	}
+
+	state, diags := resource.RefreshWithoutUpgrade(ctx, imported[0].State(), meta)
+	if diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+
+	return state, nil
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	messages := make([]string, 0)
+	for _, v := range diags {
+		if v.Severity != diag.Error {
+			continue
+		}
+		message := v.Summary
+		if v.Detail != "" {
+			message = fmt.Sprintf("%s: %s", v.Summary, v.Detail)
+		}
+		messages = append(messages, message)
+	}
+	return errors.New(strings.Join(messages, "\n"))
+}
+
+// terraformName returns a name for the Terraform resource from the name of the ARM resource, which is the
+// last segment of the Resource ID
+func terraformName(id string) string {
+	segments := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	input := strings.ToLower(segments[len(segments)-1])
+
+	output := ""
+	for _, r := range input {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			output += string(r)
+			continue
+		}
+		if !strings.HasSuffix(output, "_") {
+			output += "_"
+		}
+	}
+	output = strings.Trim(output, "_")
+
+	if output == "" {
+		return "resource"
+	}
+	if output[0] >= '0' && output[0] <= '9' {
+		output = "r_" + output
+	}
+	return output
+}
+
+// uniqueName returns the name, suffixed with a number where this name has already been used for this Resource Type
+func uniqueName(existing map[string]int, resourceType, name string) string {
+	key := fmt.Sprintf("%s.%s", resourceType, name)
+	existing[key]++
+	if count := existing[key]; count > 1 {
+		return uniqueName(existing, resourceType, fmt.Sprintf("%s_%d", name, count))
+	}
+	return name
+}
+
+func renderConfiguration(exported []exportedResource, failed []failedResource) string {
+	var b strings.Builder
+	for i, v := range exported {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		renderResource(&b, v)
+	}
+
+	if len(failed) > 0 {
+		if len(exported) > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("# The following resources could not be exported:\n")
+		for _, v := range failed {
+			b.WriteString(fmt.Sprintf("#\n# %s\n", v.id))
+			for _, line := range strings.Split(v.reason, "\n") {
+				if line != "" {
+					b.WriteString(fmt.Sprintf("#   %s\n", line))
+				}
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func renderImportScript(exported []exportedResource) string {
+	var b strings.Builder
+	b.WriteString("#!/usr/bin/env bash\nset -euo pipefail\n\n")
+	for _, v := range exported {
+		b.WriteString(fmt.Sprintf("terraform import %s %s\n", shellQuote(fmt.Sprintf("%s.%s", v.resourceType, v.name)), shellQuote(v.id)))
+	}
+	return b.String()
+}
+
+func renderResource(b *strings.Builder, r exportedResource) {
+	b.WriteString(fmt.Sprintf("resource %q %q {\n", r.resourceType, r.name))
+	if len(r.alternateType) > 0 {
+		b.WriteString(fmt.Sprintf("  # this Resource ID is also supported by: %s\n", strings.Join(r.alternateType, ", ")))
+	}
+
+	d := r.resource.Data(r.state)
+	values := make(map[string]interface{}, len(r.resource.Schema))
+	for k := range r.resource.Schema {
+		values[k] = d.Get(k)
+	}
+	renderBlock(b, 1, r.resource.Schema, values)
+
+	b.WriteString("}\n")
+}
+
+// renderBlock renders the arguments which can be specified in the configuration, followed by any nested blocks
+func renderBlock(b *strings.Builder, depth int, schemaMap map[string]*schema.Schema, values map[string]interface{}) {
+	indent := strings.Repeat("  ", depth)
+
+	keys := make([]string, 0, len(schemaMap))
+	for k, v := range schemaMap {
+		if !v.Required && !v.Optional || v.Deprecated != "" {
+			continue
+		}
+		if !v.Required && isDefaultValue(v, values[k]) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	blocks := make([]string, 0)
+	for _, k := range keys {
+		s := schemaMap[k]
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+			continue
+		}
+
+		if s.Sensitive {
+			b.WriteString(fmt.Sprintf("%s# `%s` is sensitive and must be specified\n", indent, k))
+			continue
+		}
+		b.WriteString(fmt.Sprintf("%s%s = %s\n", indent, k, renderValue(values[k], depth)))
+	}
+
+	for _, k := range blocks {
+		elem := schemaMap[k].Elem.(*schema.Resource)
+		for _, item := range listValue(values[k]) {
+			nested, _ := item.(map[string]interface{})
+			if len(nested) == 0 {
+				b.WriteString(fmt.Sprintf("\n%s%s {}\n", indent, k))
+				continue
+			}
+
+			b.WriteString(fmt.Sprintf("\n%s%s {\n", indent, k))
+			renderBlock(b, depth+1, elem.Schema, nested)
+			b.WriteString(fmt.Sprintf("%s}\n", indent))
+		}
+	}
+}
+
+// isDefaultValue returns whether the value is the default for this argument, and so can be omitted
+func isDefaultValue(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, value)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return len(listValue(value)) == 0
+}
+
+func listValue(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+func renderValue(value interface{}, depth int) string {
+	switch v := value.(type) {
+	case string:
+		return quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		indent := strings.Repeat("  ", depth+1)
+		lines := make([]string, 0, len(keys))
+		for _, k := range keys {
+			lines = append(lines, fmt.Sprintf("%s%s = %s", indent, quote(k), renderValue(v[k], depth+1)))
+		}
+		return fmt.Sprintf("{\n%s\n%s}", strings.Join(lines, "\n"), strings.Repeat("  ", depth))
+	}
+
+	if items := listValue(value); items != nil {
+		rendered := make([]string, 0, len(items))
+		for _, item := range items {
+			rendered = append(rendered, renderValue(item, depth))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(rendered, ", "))
+	}
+
+	return "null"
+}
+
+// quote returns the value as a quoted HCL string, escaping any template sequences
+func quote(input string) string {
+	var b strings.Builder
+	b.WriteString(`"`)
+	for i, r := range input {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(r)
+			if strings.HasPrefix(input[i+1:], "{") {
+				b.WriteRune(r)
+			}
+		default:
+			if r < 0x20 {
+				b.WriteString(fmt.Sprintf(`\u%04x`, r))
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(`"`)
+	return b.String()
+}
+
+func shellQuote(input string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(input, "'", `'\''`))
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestParseScope(t *testing.T) {
+	cases := []struct {
+		input             string
+		subscriptionId    string
+		resourceGroupName string
+		error             bool
+	}{
+		{
+			input: "",
+			error: true,
+		},
+		{
+			input:          "/subscriptions/12345678-1234-9876-4563-123456789012",
+			subscriptionId: "12345678-1234-9876-4563-123456789012",
+		},
+		{
+			input:             "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+			subscriptionId:    "12345678-1234-9876-4563-123456789012",
+			resourceGroupName: "group1",
+		},
+		{
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			error: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		subscriptionId, resourceGroupName, err := parseScope(v.input)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if subscriptionId != v.subscriptionId || resourceGroupName != v.resourceGroupName {
+			t.Fatalf("expected %q / %q but got %q / %q", v.subscriptionId, v.resourceGroupName, subscriptionId, resourceGroupName)
+		}
+	}
+}
+
+func TestBuildCandidates(t *testing.T) {
+	validateType := func(resourceType string) pluginsdk.IDValidationFunc {
+		return func(id string) error {
+			if !strings.Contains(id, fmt.Sprintf("/providers/%s/", resourceType)) {
+				return fmt.Errorf("expected a %s ID", resourceType)
+			}
+			return nil
+		}
+	}
+	resources := map[string]*schema.Resource{
+		"azurerm_linux_virtual_machine": {
+			Importer: pluginsdk.ImporterValidatingResourceId(validateType("Microsoft.Compute/virtualMachines")),
+		},
+		"azurerm_windows_virtual_machine": {
+			Importer: pluginsdk.ImporterValidatingResourceId(validateType("Microsoft.Compute/virtualMachines")),
+		},
+		"azurerm_virtual_machine": {
+			DeprecationMessage: "deprecated",
+			Importer:           pluginsdk.ImporterValidatingResourceId(validateType("Microsoft.Compute/virtualMachines")),
+		},
+		"azurerm_virtual_network": {
+			Importer: pluginsdk.ImporterValidatingResourceId(validateType("Microsoft.Network/virtualNetworks")),
+		},
+		"azurerm_any_resource": {
+			Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+				return nil
+			}),
+		},
+		"azurerm_no_validation": {
+			Importer: &schema.ResourceImporter{
+				StateContext: schema.ImportStatePassthroughContext,
+			},
+		},
+	}
+
+	candidates := buildCandidates(resources)
+	if len(candidates) != 4 {
+		t.Fatalf("expected 4 candidates but got %d", len(candidates))
+	}
+
+	matches := matchingCandidates(candidates, "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1")
+	actual := make([]string, 0)
+	for _, v := range matches {
+		actual = append(actual, v.resourceType)
+	}
+	expected := "azurerm_linux_virtual_machine,azurerm_windows_virtual_machine,azurerm_virtual_machine"
+	if strings.Join(actual, ",") != expected {
+		t.Fatalf("expected the matches to be %q but got %q", expected, strings.Join(actual, ","))
+	}
+}
+
+func TestTerraformName(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Example-Resources",
+			"example_resources",
+		},
+		{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/1storage",
+			"r_1storage",
+		},
+		{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites/--.--",
+			"resource",
+		},
+	}
+
+	for _, v := range cases {
+		if actual := terraformName(v.in); actual != v.out {
+			t.Fatalf("expected %q but got %q", v.out, actual)
+		}
+	}
+
+	names := map[string]int{}
+	for _, expected := range []string{"example", "example_2", "example_3"} {
+		if actual := uniqueName(names, "azurerm_resource_group", "example"); actual != expected {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+	if actual := uniqueName(names, "azurerm_virtual_network", "example"); actual != "example" {
+		t.Fatalf("expected the name to be unique per Resource Type but got %q", actual)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{
+			"hello",
+			`"hello"`,
+		},
+		{
+			"line1\nline2\t\"quoted\" \\",
+			`"line1\nline2\t\"quoted\" \\"`,
+		},
+		{
+			"${var.example} %{if true} $5 100%",
+			`"$${var.example} %%{if true} $5 100%"`,
+		},
+	}
+
+	for _, v := range cases {
+		if actual := quote(v.in); actual != v.out {
+			t.Fatalf("expected %s but got %s", v.out, actual)
+		}
+	}
+}
+
+func TestRenderResource(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/examples/example1",
+		Attributes: map[string]string{
+			"name":            "example1",
+			"enabled":         "false",
+			"description":     "",
+			"password":        "secret",
+			"addresses.#":     "2",
+			"addresses.0":     "10.0.0.0/16",
+			"addresses.1":     "10.1.0.0/16",
+			"rule.#":          "1",
+			"rule.0.priority": "100",
+			"rule.0.id":       "rule1",
+			"tags.%":          "1",
+			"tags.env":        "${production}",
+			"fqdn":            "example1.example.com",
+		},
+	}
+
+	var b strings.Builder
+	renderResource(&b, exportedResource{
+		resourceType:  "azurerm_example",
+		name:          "example1",
+		resource:      resource,
+		state:         state,
+		alternateType: []string{"azurerm_other_example"},
+	})
+
+	expected := `resource "azurerm_example" "example1" {
+  # this Resource ID is also supported by: azurerm_other_example
+  addresses = ["10.0.0.0/16", "10.1.0.0/16"]
+  enabled = false
+  name = "example1"
+  # ` + "`password`" + ` is sensitive and must be specified
+  tags = {
+    "env" = "$${production}"
+  }
+
+  rule {
+    priority = 100
+  }
+}
+`
+	if actual := b.String(); actual != expected {
+		t.Fatalf("expected:\n\n%s\n\nbut got:\n\n%s", expected, actual)
+	}
+}
+
+func TestRenderImportScript(t *testing.T) {
+	actual := renderImportScript([]exportedResource{
+		{
+			id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/it's",
+			resourceType: "azurerm_resource_group",
+			name:         "it_s",
+		},
+	})
+
+	expected := `terraform import 'azurerm_resource_group.it_s' '/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/it'\''s'`
+	if !strings.Contains(actual, expected) {
+		t.Fatalf("expected the import script to contain %q but got:\n\n%s", expected, actual)
+	}
+}
+
+func TestRunReplaysRecordedResourceGroup(t *testing.T) {
+	// run configures the cassette via the environment, which is restored once the test completes
+	t.Setenv(common.CassetteModeEnvVar, "")
+	t.Setenv(common.CassetteDirEnvVar, "")
+
+	outputPath := t.TempDir()
+	input := exportInput{
+		scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+		outputPath: outputPath,
+		replayPath: filepath.Join("testdata", "resource-group"),
+		logger:     log.New(io.Discard, "", 0),
+	}
+	if err := run(context.TODO(), input); err != nil {
+		t.Fatalf("running export: %+v", err)
+	}
+
+	config, err := os.ReadFile(filepath.Join(outputPath, "main.tf"))
+	if err != nil {
+		t.Fatalf("reading main.tf: %+v", err)
+	}
+	expectedConfig := `resource "azurerm_resource_group" "example_resources" {
+  location = "westeurope"
+  name = "example-resources"
+  tags = {
+    "environment" = "testing"
+  }
+}
+`
+	if string(config) != expectedConfig {
+		t.Fatalf("expected main.tf to be:\n\n%s\n\nbut got:\n\n%s", expectedConfig, string(config))
+	}
+
+	script, err := os.ReadFile(filepath.Join(outputPath, "import.sh"))
+	if err != nil {
+		t.Fatalf("reading import.sh: %+v", err)
+	}
+	expectedScript := `#!/usr/bin/env bash
+set -euo pipefail
+
+terraform import 'azurerm_resource_group.example_resources' '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources'
+`
+	if string(script) != expectedScript {
+		t.Fatalf("expected import.sh to be:\n\n%s\n\nbut got:\n\n%s", expectedScript, string(script))
+	}
+}
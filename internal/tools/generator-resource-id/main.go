@@ -21,10 +21,23 @@ var packagesUsingAlias = map[string]struct{}{
 	"appconfiguration": {},
 }
 
+// exampleIds allows the `-id` flag to be specified multiple times
+type exampleIds []string
+
+func (e *exampleIds) String() string {
+	return strings.Join(*e, ", ")
+}
+
+func (e *exampleIds) Set(value string) error {
+	*e = append(*e, value)
+	return nil
+}
+
 func main() {
 	servicePackagePath := flag.String("path", "", "The relative path to the service package")
 	name := flag.String("name", "", "The name of this Resource Type")
-	id := flag.String("id", "", "An example of this Resource ID")
+	ids := exampleIds{}
+	flag.Var(&ids, "id", "An example of this Resource ID - which can be specified multiple times or contain a `{scope}` placeholder for a Resource ID which exists at multiple Scopes")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
 	showHelp := flag.Bool("help", false, "Display this message")
 
@@ -35,12 +48,24 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
+	if err := run(*servicePackagePath, *name, ids, *rewrite); err != nil {
 		panic(err)
 	}
 }
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
+// codeGenerator generates the Parser, Validator and associated Tests for a Resource ID
+type codeGenerator interface {
+	Code() string
+	TestCode() string
+	ValidatorCode() string
+	ValidatorTestCode() string
+}
+
+func run(servicePackagePath, name string, ids []string, shouldRewrite bool) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("at least one example Resource ID must be specified via `-id`")
+	}
+
 	servicePackage, err := parseServicePackageName(servicePackagePath)
 	if err != nil {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
@@ -62,14 +87,31 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		// e.g. "webtest" in applicationInsights
 		fileName += "_id"
 	}
-	resourceId, err := NewResourceID(name, *servicePackage, id)
-	if err != nil {
-		return err
-	}
 
-	generator := ResourceIdGenerator{
-		ResourceId:    *resourceId,
-		ShouldRewrite: shouldRewrite,
+	var generator codeGenerator
+	if len(ids) == 1 && !strings.Contains(ids[0], scopePlaceholder) {
+		resourceId, err := NewResourceID(name, *servicePackage, ids[0])
+		if err != nil {
+			return err
+		}
+
+		generator = ResourceIdGenerator{
+			ResourceId:    *resourceId,
+			ShouldRewrite: shouldRewrite,
+		}
+	} else {
+		variants, err := NewResourceIdVariants(name, *servicePackage, ids)
+		if err != nil {
+			return err
+		}
+
+		generator = MultiScopeResourceIdGenerator{
+			TypeName:           name,
+			ServicePackageName: *servicePackage,
+			TestPackageSuffix:  testPackageSuffix(*servicePackage),
+			Variants:           variants,
+			ShouldRewrite:      shouldRewrite,
+		}
 	}
 
 	parserFilePath := fmt.Sprintf("%s/%s.go", parsersPath, fileName)
@@ -305,11 +347,6 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 		fmtString = strings.Replace(fmtString, segment.SegmentValue, "%s", 1)
 	}
 
-	packageSuffix := ""
-	if _, ok := packagesUsingAlias[servicePackageName]; ok {
-		packageSuffix = "_test"
-	}
-
 	return &ResourceId{
 		IDFmt:              fmtString,
 		IDRaw:              resourceId,
@@ -318,10 +355,17 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 		Segments:           segments,
 		ServicePackageName: servicePackageName,
 		TypeName:           typeName,
-		TestPackageSuffix:  packageSuffix,
+		TestPackageSuffix:  testPackageSuffix(servicePackageName),
 	}, nil
 }
 
+func testPackageSuffix(servicePackageName string) string {
+	if _, ok := packagesUsingAlias[servicePackageName]; ok {
+		return "_test"
+	}
+	return ""
+}
+
 type ResourceIdGenerator struct {
 	ResourceId
 
@@ -383,20 +427,20 @@ func New%[1]sID(%[2]s string) %[1]sId {
 `, id.TypeName, argumentsStr, assignmentsStr)
 }
 
-func (id ResourceIdGenerator) codeForDescription() string {
-	makeHumanReadable := func(input string) string {
-		chars := make([]rune, 0)
-		for _, c := range input {
-			if unicode.IsUpper(c) {
-				chars = append(chars, ' ')
-			}
-
-			chars = append(chars, c)
+func makeHumanReadable(input string) string {
+	chars := make([]rune, 0)
+	for _, c := range input {
+		if unicode.IsUpper(c) {
+			chars = append(chars, ' ')
 		}
-		out := string(chars)
-		return strings.TrimSpace(out)
+
+		chars = append(chars, c)
 	}
+	out := string(chars)
+	return strings.TrimSpace(out)
+}
 
+func (id ResourceIdGenerator) codeForDescription() string {
 	formatKeys := make([]string, 0)
 	for _, segment := range id.Segments {
 		if segment.FieldName == "SubscriptionId" {
@@ -1030,6 +1074,770 @@ func Test%[2]sID(t *testing.T) {
 `, id.TestPackageSuffix, id.TypeName, testCasesStr, id.ServicePackageName)
 }
 
+// scopePlaceholder can be used at the start of an example Resource ID to generate a Resource ID for each of the exampleScopes
+const scopePlaceholder = "{scope}"
+
+var exampleScopes = []struct {
+	name string
+	id   string
+}{
+	{name: "ManagementGroup", id: "/providers/Microsoft.Management/managementGroups/group1"},
+	{name: "Subscription", id: "/subscriptions/12345678-1234-9876-4563-123456789012"},
+	{name: "ResourceGroup", id: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"},
+	{name: "Resource", id: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1"},
+}
+
+type ResourceIdComponent struct {
+	// Key is the key used for this component in the Resource ID e.g. `resourceGroups`
+	Key string
+
+	// Value is the example value used for this component in the Resource ID
+	Value string
+
+	// Segment is the Segment for this component, which is nil for a Resource Provider
+	Segment *ResourceIdSegment
+
+	// InScope specifies whether this component is part of the Scope
+	InScope bool
+}
+
+type ResourceIdVariant struct {
+	// ScopeName is the type of Scope which this Resource ID exists within e.g. `ResourceGroup`
+	ScopeName string
+
+	// TypeName is the name of this Resource ID, prefixed with the ScopeName
+	TypeName string
+
+	IDFmt    string
+	IDRaw    string
+	ScopeRaw string
+
+	// IsResourceScope specifies that the Scope can be any Resource ID, rather than a fixed set of components
+	IsResourceScope bool
+
+	// Components are the key/value pairs within the Resource ID, which excludes the Scope when IsResourceScope is set
+	Components []ResourceIdComponent
+}
+
+// Segments returns the Segments which make up the fields for this Resource ID
+func (v ResourceIdVariant) Segments() []ResourceIdSegment {
+	segments := make([]ResourceIdSegment, 0)
+	if v.IsResourceScope {
+		segments = append(segments, ResourceIdSegment{
+			ArgumentName: "resourceId",
+			FieldName:    "ResourceId",
+			SegmentValue: v.ScopeRaw,
+		})
+	}
+	for _, component := range v.Components {
+		if component.Segment != nil {
+			segments = append(segments, *component.Segment)
+		}
+	}
+	return segments
+}
+
+// ScopeValue returns the expected value for the Scope of the example Resource ID
+func (v ResourceIdVariant) ScopeValue() string {
+	if v.ScopeRaw == "" {
+		return "/"
+	}
+	return v.ScopeRaw
+}
+
+// truncatedIds returns the example Resource ID truncated prior to the key/value for each component, which are invalid
+func (v ResourceIdVariant) truncatedIds() []struct{ name, id string } {
+	prefix := "/"
+	if v.IsResourceScope {
+		prefix = v.ScopeRaw + "/"
+	}
+
+	output := make([]struct{ name, id string }, 0)
+	for _, component := range v.Components {
+		name := "Resource Provider"
+		if component.Segment != nil {
+			name = component.Segment.FieldName
+		}
+		output = append(output, struct{ name, id string }{name: name, id: prefix})
+		prefix += component.Key + "/"
+		output = append(output, struct{ name, id string }{name: fmt.Sprintf("value for %s", name), id: prefix})
+		prefix += component.Value + "/"
+	}
+	return output
+}
+
+func NewResourceIdVariants(typeName, servicePackageName string, ids []string) ([]ResourceIdVariant, error) {
+	variants := make([]ResourceIdVariant, 0)
+	for _, id := range ids {
+		if !strings.Contains(id, scopePlaceholder) {
+			variant, err := newResourceIdVariant(typeName, servicePackageName, id, false)
+			if err != nil {
+				return nil, err
+			}
+			variants = append(variants, *variant)
+			continue
+		}
+
+		if !strings.HasPrefix(id, scopePlaceholder) || strings.Count(id, scopePlaceholder) > 1 {
+			return nil, fmt.Errorf("the %q placeholder can only be used at the start of the Resource ID, got %q", scopePlaceholder, id)
+		}
+		for _, scope := range exampleScopes {
+			variant, err := newResourceIdVariant(typeName, servicePackageName, strings.Replace(id, scopePlaceholder, scope.id, 1), scope.name == "Resource")
+			if err != nil {
+				return nil, err
+			}
+			variants = append(variants, *variant)
+		}
+	}
+
+	// any Resource ID can be used as the Scope, so this needs to be parsed last to ensure the other Scopes are matched first
+	sort.SliceStable(variants, func(i, j int) bool {
+		return !variants[i].IsResourceScope && variants[j].IsResourceScope
+	})
+
+	scopes := make(map[string]struct{})
+	for _, variant := range variants {
+		if _, ok := scopes[variant.ScopeName]; ok {
+			return nil, fmt.Errorf("multiple Resource ID's were specified within the %q Scope", makeHumanReadable(variant.ScopeName))
+		}
+		scopes[variant.ScopeName] = struct{}{}
+	}
+
+	return variants, nil
+}
+
+func newResourceIdVariant(typeName, servicePackageName, id string, isResourceScope bool) (*ResourceIdVariant, error) {
+	// the Scope is everything prior to the last Resource Provider
+	index := strings.LastIndex(id, "/providers/")
+	if index == -1 {
+		return nil, fmt.Errorf("the Resource ID %q must contain a Resource Provider", id)
+	}
+	scope := id[0:index]
+
+	path := id
+	scopeLength := 0
+	if isResourceScope {
+		path = id[index:]
+	} else if scope != "" {
+		scopeLength = len(strings.Split(strings.TrimPrefix(scope, "/"), "/"))
+	}
+
+	resourceId, err := NewResourceID(typeName, servicePackageName, path)
+	if err != nil {
+		return nil, err
+	}
+
+	idFmt := ""
+	if isResourceScope {
+		idFmt = "%s"
+	}
+	split := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	components := make([]ResourceIdComponent, 0)
+	for i := 0; i < len(split); i += 2 {
+		component := ResourceIdComponent{
+			Key:     split[i],
+			Value:   split[i+1],
+			InScope: i < scopeLength,
+		}
+
+		if component.Key == "providers" {
+			idFmt += fmt.Sprintf("/%s/%s", component.Key, component.Value)
+		} else {
+			segment := resourceId.Segments[len(components)-countProviders(components)]
+			component.Segment = &segment
+			idFmt += fmt.Sprintf("/%s/%%s", component.Key)
+		}
+
+		components = append(components, component)
+	}
+
+	scopeName := scopeNameForId(scope)
+	return &ResourceIdVariant{
+		ScopeName:       scopeName,
+		TypeName:        scopeName + typeName,
+		IDFmt:           idFmt,
+		IDRaw:           id,
+		ScopeRaw:        scope,
+		IsResourceScope: isResourceScope,
+		Components:      components,
+	}, nil
+}
+
+func countProviders(components []ResourceIdComponent) int {
+	count := 0
+	for _, component := range components {
+		if component.Segment == nil {
+			count++
+		}
+	}
+	return count
+}
+
+func scopeNameForId(scope string) string {
+	split := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+	switch {
+	case scope == "":
+		return "Tenant"
+	case len(split) == 4 && split[0] == "providers" && strings.EqualFold(split[1], "Microsoft.Management") && split[2] == "managementGroups":
+		return "ManagementGroup"
+	case len(split) == 2 && split[0] == "subscriptions":
+		return "Subscription"
+	case len(split) == 4 && split[0] == "subscriptions" && split[2] == "resourceGroups":
+		return "ResourceGroup"
+	}
+	return "Resource"
+}
+
+// MultiScopeResourceIdGenerator generates a Resource ID which can exist within multiple Scopes, where each Scope
+// is a separate type implementing a common interface
+type MultiScopeResourceIdGenerator struct {
+	TypeName           string
+	ServicePackageName string
+	TestPackageSuffix  string
+	Variants           []ResourceIdVariant
+	ShouldRewrite      bool
+}
+
+func (id MultiScopeResourceIdGenerator) scopesDescription() string {
+	scopes := make([]string, 0)
+	for _, variant := range id.Variants {
+		scopes = append(scopes, makeHumanReadable(variant.ScopeName))
+	}
+	if len(scopes) == 1 {
+		return scopes[0]
+	}
+	return fmt.Sprintf("%s or %s", strings.Join(scopes[0:len(scopes)-1], ", "), scopes[len(scopes)-1])
+}
+
+func (id MultiScopeResourceIdGenerator) packagePrefix() string {
+	if id.TestPackageSuffix != "" {
+		return "parse."
+	}
+	return ""
+}
+
+func (id MultiScopeResourceIdGenerator) Code() string {
+	variants := make([]string, 0)
+	for _, variant := range id.Variants {
+		variants = append(variants, id.codeForVariant(variant))
+	}
+
+	return fmt.Sprintf(`
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+%s
+%s
+%s
+%s
+`, id.codeForType(), id.codeForParser(false), id.codeForParser(true), strings.Join(variants, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) codeForType() string {
+	assertions := make([]string, 0)
+	for _, variant := range id.Variants {
+		assertions = append(assertions, fmt.Sprintf("\t_ %sId = %sId{}", id.TypeName, variant.TypeName))
+	}
+
+	return fmt.Sprintf(`
+// %[1]sId is a %[2]s ID, which can exist within a %[3]s
+type %[1]sId interface {
+	resourceids.Id
+
+	// Scope returns the ID of the Scope which this %[2]s exists within
+	Scope() string
+}
+
+var (
+%[4]s
+)
+`, id.TypeName, makeHumanReadable(id.TypeName), id.scopesDescription(), strings.Join(assertions, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) codeForParser(insensitively bool) string {
+	suffix := ""
+	if insensitively {
+		if !id.ShouldRewrite {
+			// this only exists to workaround broken API's to patch those ID's, so shouldn't be used in most circumstances
+			return ""
+		}
+		suffix = "Insensitively"
+	}
+
+	statements := make([]string, 0)
+	for _, variant := range id.Variants {
+		statements = append(statements, fmt.Sprintf(`	if id, err := %[1]sID%[2]s(input); err == nil {
+		return *id, nil
+	}`, variant.TypeName, suffix))
+	}
+
+	return fmt.Sprintf(`
+// %[1]sID%[2]s parses a %[1]s ID into the %[1]sId for the Scope it exists within
+func %[1]sID%[2]s(input string) (%[1]sId, error) {
+%[3]s
+
+	return nil, fmt.Errorf("parsing %%q: expected a %[4]s ID within a %[5]s", input)
+}
+`, id.TypeName, suffix, strings.Join(statements, "\n\n"), makeHumanReadable(id.TypeName), id.scopesDescription())
+}
+
+func (id MultiScopeResourceIdGenerator) codeForVariant(variant ResourceIdVariant) string {
+	generator := ResourceIdGenerator{
+		ResourceId: ResourceId{
+			TypeName: variant.TypeName,
+			IDFmt:    variant.IDFmt,
+			IDRaw:    variant.IDRaw,
+			Segments: variant.Segments(),
+		},
+	}
+
+	return fmt.Sprintf(`
+%s
+%s
+%s
+%s
+%s
+%s
+%s
+`, generator.codeForType(), generator.codeForConstructor(), generator.codeForDescription(), generator.codeForFormatter(), id.codeForVariantScope(variant), id.codeForVariantParser(variant, false), id.codeForVariantParser(variant, true))
+}
+
+func (id MultiScopeResourceIdGenerator) codeForVariantScope(variant ResourceIdVariant) string {
+	body := `return "/"`
+	switch {
+	case variant.IsResourceScope:
+		body = "return id.ResourceId"
+
+	case variant.ScopeRaw != "":
+		scopeFmt := ""
+		arguments := make([]string, 0)
+		for _, component := range variant.Components {
+			if !component.InScope {
+				continue
+			}
+			if component.Segment == nil {
+				scopeFmt += fmt.Sprintf("/%s/%s", component.Key, component.Value)
+				continue
+			}
+			scopeFmt += fmt.Sprintf("/%s/%%s", component.Key)
+			arguments = append(arguments, fmt.Sprintf("id.%s", component.Segment.FieldName))
+		}
+		body = fmt.Sprintf("fmtString := %q\n\treturn fmt.Sprintf(fmtString, %s)", scopeFmt, strings.Join(arguments, ", "))
+	}
+
+	return fmt.Sprintf(`
+// Scope returns the ID of the %[2]s which this %[3]s exists within
+func (id %[1]sId) Scope() string {
+	%[4]s
+}
+`, variant.TypeName, makeHumanReadable(variant.ScopeName), makeHumanReadable(id.TypeName), body)
+}
+
+func (id MultiScopeResourceIdGenerator) codeForVariantParser(variant ResourceIdVariant, insensitively bool) string {
+	if insensitively && !id.ShouldRewrite {
+		return ""
+	}
+
+	compare := func(index int, value string) string {
+		if insensitively {
+			return fmt.Sprintf("!strings.EqualFold(segments[%d], %q)", index, value)
+		}
+		return fmt.Sprintf("segments[%d] != %q", index, value)
+	}
+
+	checks := make([]string, 0)
+	assignments := make([]string, 0)
+	if variant.IsResourceScope {
+		assignments = append(assignments, "\t\tResourceId: resourceId,")
+	}
+	for i, component := range variant.Components {
+		index := i * 2
+		if component.Segment == nil {
+			checks = append(checks, fmt.Sprintf(`
+	if %s || %s {
+		return nil, fmt.Errorf("ID was missing the '%s/%s' element")
+	}`, compare(index, component.Key), compare(index+1, component.Value), component.Key, component.Value))
+			continue
+		}
+
+		checks = append(checks, fmt.Sprintf(`
+	if %s || segments[%d] == "" {
+		return nil, fmt.Errorf("ID was missing the '%s' element")
+	}`, compare(index, component.Key), index+1, component.Key))
+		assignments = append(assignments, fmt.Sprintf("\t\t%s:\tsegments[%d],", component.Segment.FieldName, index+1))
+	}
+
+	segmentsStatement := fmt.Sprintf(`
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != %[2]d {
+		return nil, fmt.Errorf("expected the %[1]s ID %%q to contain %[2]d segments but got %%d", input, len(segments))
+	}
+`, makeHumanReadable(variant.TypeName), len(variant.Components)*2)
+	scopeStatement := ""
+	if variant.IsResourceScope {
+		segmentsStatement = fmt.Sprintf(`
+	// the Scope can be any Resource ID, which is followed by %[2]d segments
+	components := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	scopeLength := len(components) - %[2]d
+	if scopeLength <= 0 {
+		return nil, fmt.Errorf("expected the %[1]s ID %%q to contain a Resource ID as the Scope", input)
+	}
+	segments := components[scopeLength:]
+`, makeHumanReadable(variant.TypeName), len(variant.Components)*2)
+		scopeStatement = fmt.Sprintf(`
+	resourceId := "/" + strings.Join(components[0:scopeLength], "/")
+	scope, err := resourceids.ParseAzureResourceID(resourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Scope %%q for the %[1]s ID %%q: %%+v", resourceId, input, err)
+	}
+	if scope.Provider == "" || len(scope.Path) == 0 {
+		return nil, fmt.Errorf("expected the Scope %%q for the %[1]s ID %%q to be a Resource ID", resourceId, input)
+	}
+`, makeHumanReadable(variant.TypeName))
+	}
+
+	description := fmt.Sprintf("// %[1]sID parses a %[1]s ID into an %[1]sId struct", variant.TypeName)
+	functionName := fmt.Sprintf("%sID", variant.TypeName)
+	if insensitively {
+		description = fmt.Sprintf(`// %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
+// This should only be used to parse an ID for rewriting, the %[1]sID
+// method should be used instead for validation etc.`, variant.TypeName)
+		functionName = fmt.Sprintf("%sIDInsensitively", variant.TypeName)
+	}
+
+	return fmt.Sprintf(`
+%[1]s
+func %[2]s(input string) (*%[3]sId, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("expected the %[4]s ID %%q to begin with a '/'", input)
+	}
+%[5]s
+%[6]s
+%[7]s
+	return &%[3]sId{
+%[8]s
+	}, nil
+}
+`, description, functionName, variant.TypeName, makeHumanReadable(variant.TypeName), segmentsStatement, strings.Join(checks, "\n"), scopeStatement, strings.Join(assignments, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) TestCode() string {
+	importLine := ""
+	if id.TestPackageSuffix != "" {
+		importLine = fmt.Sprintf("\"github.com/hashicorp/terraform-provider-azurerm/internal/services/%s/parse\"", id.ServicePackageName)
+	}
+
+	tests := make([]string, 0)
+	for _, variant := range id.Variants {
+		tests = append(tests, id.testCodeForVariantFormatter(variant))
+		tests = append(tests, id.testCodeForVariantParser(variant, false))
+		tests = append(tests, id.testCodeForVariantParser(variant, true))
+	}
+
+	return fmt.Sprintf(`
+package parse%s
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	%s
+)
+
+%s
+%s
+`, id.TestPackageSuffix, importLine, id.testCodeForParser(), strings.Join(tests, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) expectedValue(variant ResourceIdVariant) string {
+	assignments := make([]string, 0)
+	for _, segment := range variant.Segments() {
+		assignments = append(assignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
+	}
+	return fmt.Sprintf("%s%sId{\n%s\n\t\t\t}", id.packagePrefix(), variant.TypeName, strings.Join(assignments, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) testCodeForParser() string {
+	testCases := make([]string, 0)
+	testCases = append(testCases, `
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},`)
+	for _, variant := range id.Variants {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// %[1]s
+			Input:    %[2]q,
+			Expected: %[3]s,
+		},`, makeHumanReadable(variant.ScopeName), variant.IDRaw, id.expectedValue(variant)))
+	}
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// additional segments
+			Input: %q,
+			Error: true,
+		},`, id.Variants[0].IDRaw+"/extra/value"))
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// upper-cased
+			Input: %q,
+			Error: true,
+		},`, strings.ToUpper(id.Variants[0].IDRaw)))
+
+	return fmt.Sprintf(`
+func Test%[1]sID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected %[2]s%[1]sId
+	}{
+%[3]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[2]s%[1]sID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %%+v but got %%+v", v.Expected, actual)
+		}
+		if actual.Scope() != v.Expected.Scope() {
+			t.Fatalf("Expected the Scope %%q but got %%q", v.Expected.Scope(), actual.Scope())
+		}
+	}
+}
+`, id.TypeName, id.packagePrefix(), strings.Join(testCases, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) testCodeForVariantFormatter(variant ResourceIdVariant) string {
+	arguments := make([]string, 0)
+	for _, segment := range variant.Segments() {
+		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDFormatter(t *testing.T) {
+	id := %[2]sNew%[1]sID(%[3]s)
+	if actual, expected := id.ID(), %[4]q; actual != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual)
+	}
+	if actual, expected := id.Scope(), %[5]q; actual != expected {
+		t.Fatalf("Expected the Scope %%q but got %%q", expected, actual)
+	}
+}
+`, variant.TypeName, id.packagePrefix(), strings.Join(arguments, ", "), variant.IDRaw, variant.ScopeValue())
+}
+
+func (id MultiScopeResourceIdGenerator) testCodeForVariantParser(variant ResourceIdVariant, insensitively bool) string {
+	suffix := ""
+	if insensitively {
+		if !id.ShouldRewrite {
+			// this functionality isn't enabled by default
+			return ""
+		}
+		suffix = "Insensitively"
+	}
+
+	testCases := make([]string, 0)
+	testCases = append(testCases, `
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},`)
+	for _, truncated := range variant.truncatedIds() {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// missing %s
+			Input: %q,
+			Error: true,
+		},`, truncated.name, truncated.id))
+	}
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// valid
+			Input:    %q,
+			Expected: &%s,
+		},`, variant.IDRaw, id.expectedValue(variant)))
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// additional segments
+			Input: %q,
+			Error: true,
+		},`, variant.IDRaw+"/extra/value"))
+
+	if insensitively {
+		transformations := []struct {
+			name      string
+			transform func(in string) string
+		}{
+			{name: "lower-cased segment names", transform: strings.ToLower},
+			{name: "upper-cased segment names", transform: strings.ToUpper},
+		}
+		for _, transformation := range transformations {
+			// only the keys outside of the Scope are transformed, since the Scope is parsed separately
+			input := variant.ScopeRaw
+			if !variant.IsResourceScope {
+				input = ""
+			}
+			for _, component := range variant.Components {
+				key := component.Key
+				if !component.InScope && component.Segment != nil {
+					key = transformation.transform(key)
+				}
+				input += fmt.Sprintf("/%s/%s", key, component.Value)
+			}
+
+			testCases = append(testCases, fmt.Sprintf(`
+		{
+			// %s
+			Input:    %q,
+			Expected: &%s,
+		},`, transformation.name, input, id.expectedValue(variant)))
+		}
+	} else {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// upper-cased
+			Input: %q,
+			Error: true,
+		},`, strings.ToUpper(variant.IDRaw)))
+	}
+
+	assignmentChecks := make([]string, 0)
+	for _, segment := range variant.Segments() {
+		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
+		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sID%[2]s(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *%[3]s%[1]sId
+	}{
+%[4]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[3]s%[1]sID%[2]s(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+%[5]s
+	}
+}
+`, variant.TypeName, suffix, id.packagePrefix(), strings.Join(testCases, "\n"), strings.Join(assignmentChecks, "\n"))
+}
+
+func (id MultiScopeResourceIdGenerator) ValidatorCode() string {
+	return ResourceIdGenerator{
+		ResourceId: ResourceId{
+			TypeName:           id.TypeName,
+			ServicePackageName: id.ServicePackageName,
+		},
+	}.ValidatorCode()
+}
+
+func (id MultiScopeResourceIdGenerator) ValidatorTestCode() string {
+	testCases := make([]string, 0)
+	testCases = append(testCases, `
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},`)
+	for _, variant := range id.Variants {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// %[1]s
+			Input: %[2]q,
+			Valid: true,
+		},
+
+		{
+			// %[1]s upper-cased
+			Input: %[3]q,
+			Valid: false,
+		},`, makeHumanReadable(variant.ScopeName), variant.IDRaw, strings.ToUpper(variant.IDRaw)))
+	}
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// additional segments
+			Input: %q,
+			Valid: false,
+		},`, id.Variants[0].IDRaw+"/extra/value"))
+
+	prefix := ""
+	importLine := `import "testing"`
+	if id.TestPackageSuffix != "" {
+		prefix = "validate."
+		importLine = fmt.Sprintf(`import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/%s/validate"
+)`, id.ServicePackageName)
+	}
+
+	return fmt.Sprintf(`package validate%[1]s
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+%[2]s
+
+func Test%[3]sID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+%[4]s
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %%s", tc.Input)
+		_, errors := %[5]s%[3]sID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %%t but got %%t", tc.Valid, valid)
+		}
+	}
+}
+`, id.TestPackageSuffix, importLine, id.TypeName, strings.Join(testCases, "\n"), prefix)
+}
+
 func goFmtAndWriteToFile(filePath, fileContents string) error {
 	fmt, err := GolangCodeFormatter{}.Format(fileContents)
 	if err != nil {
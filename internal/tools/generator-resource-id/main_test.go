@@ -1,6 +1,7 @@
 package main
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -54,3 +55,87 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestNewResourceIdVariantsScopePlaceholder(t *testing.T) {
+	variants, err := NewResourceIdVariants("PolicyExemption", "policy", []string{"{scope}/providers/Microsoft.Authorization/policyExemptions/exemption1"})
+	if err != nil {
+		t.Fatalf("building variants: %+v", err)
+	}
+
+	expected := []struct {
+		typeName string
+		idFmt    string
+		scope    string
+		fields   []string
+	}{
+		{
+			typeName: "ManagementGroupPolicyExemption",
+			idFmt:    "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Authorization/policyExemptions/%s",
+			scope:    "/providers/Microsoft.Management/managementGroups/group1",
+			fields:   []string{"ManagementGroupName", "Name"},
+		},
+		{
+			typeName: "SubscriptionPolicyExemption",
+			idFmt:    "/subscriptions/%s/providers/Microsoft.Authorization/policyExemptions/%s",
+			scope:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			fields:   []string{"SubscriptionId", "Name"},
+		},
+		{
+			typeName: "ResourceGroupPolicyExemption",
+			idFmt:    "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Authorization/policyExemptions/%s",
+			scope:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			fields:   []string{"SubscriptionId", "ResourceGroup", "Name"},
+		},
+		{
+			typeName: "ResourcePolicyExemption",
+			idFmt:    "%s/providers/Microsoft.Authorization/policyExemptions/%s",
+			scope:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1",
+			fields:   []string{"ResourceId", "Name"},
+		},
+	}
+	if len(variants) != len(expected) {
+		t.Fatalf("expected %d variants but got %d", len(expected), len(variants))
+	}
+
+	for i, v := range expected {
+		actual := variants[i]
+		if actual.TypeName != v.typeName || actual.IDFmt != v.idFmt || actual.ScopeValue() != v.scope {
+			t.Fatalf("expected %q with the format %q and scope %q but got %q with the format %q and scope %q", v.typeName, v.idFmt, v.scope, actual.TypeName, actual.IDFmt, actual.ScopeValue())
+		}
+
+		fields := make([]string, 0)
+		for _, segment := range actual.Segments() {
+			fields = append(fields, segment.FieldName)
+		}
+		if strings.Join(fields, ",") != strings.Join(v.fields, ",") {
+			t.Fatalf("expected the fields %+v for %q but got %+v", v.fields, v.typeName, fields)
+		}
+	}
+}
+
+func TestNewResourceIdVariantsMultipleIds(t *testing.T) {
+	variants, err := NewResourceIdVariants("Lock", "resource", []string{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+		"/providers/Microsoft.Authorization/locks/lock1",
+	})
+	if err != nil {
+		t.Fatalf("building variants: %+v", err)
+	}
+	if len(variants) != 2 || variants[0].TypeName != "ResourceGroupLock" || variants[1].TypeName != "TenantLock" {
+		t.Fatalf("expected a Resource Group and Tenant variant but got %+v", variants)
+	}
+	if variants[1].ScopeValue() != "/" {
+		t.Fatalf("expected the Tenant Scope to be `/` but got %q", variants[1].ScopeValue())
+	}
+
+	if _, err := NewResourceIdVariants("Lock", "resource", []string{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock2",
+	}); err == nil {
+		t.Fatalf("expected an error for multiple Resource ID's within the same Scope")
+	}
+
+	if _, err := NewResourceIdVariants("Lock", "resource", []string{"/providers/Microsoft.Authorization/locks/lock1{scope}"}); err == nil {
+		t.Fatalf("expected an error when the `{scope}` placeholder isn't at the start of the Resource ID")
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+func main() {
+	f := flag.NewFlagSet("generator-resource-provider-snapshot", flag.ExitOnError)
+
+	environment := f.String("environment", "public", "The Azure Environment to retrieve the Snapshot for")
+	outputPath := f.String("output-path", "", "The path to the file where the Snapshot should be written")
+	verbose := f.Bool("verbose", false, "Output the logs from the Provider?")
+
+	_ = f.Parse(os.Args[1:])
+
+	logger := log.New(os.Stderr, "", 0)
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	if err := run(context.Background(), *environment, *outputPath); err != nil {
+		logger.Print(err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, environment, outputPath string) error {
+	if _, ok := resourceproviders.SnapshotEnvironments[environment]; !ok {
+		environments := make([]string, 0)
+		for k := range resourceproviders.SnapshotEnvironments {
+			environments = append(environments, k)
+		}
+		sort.Strings(environments)
+		return fmt.Errorf("the Environment %q isn't supported, expected one of: %s", environment, strings.Join(environments, ", "))
+	}
+
+	if outputPath == "" {
+		return fmt.Errorf("the path to write the Snapshot to must be specified via `-output-path`")
+	}
+
+	// the Resource Providers are retrieved below, so there's no need to retrieve these when configuring the Provider
+	os.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+
+	p := provider.AzureProvider()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"features":                   []interface{}{map[string]interface{}{}},
+		"environment":                environment,
+		"skip_provider_registration": true,
+	})
+	if diags := p.Configure(ctx, config); diags.HasError() {
+		messages := make([]string, 0)
+		for _, v := range diags {
+			messages = append(messages, fmt.Sprintf("%s: %s", v.Summary, v.Detail))
+		}
+		return fmt.Errorf("configuring the Provider: %s", strings.Join(messages, "\n"))
+	}
+	client := p.Meta().(*clients.Client)
+
+	providers, err := resourceproviders.AvailableResourceProviders(ctx, client.Resource.ProvidersClient)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource Providers: %+v", err)
+	}
+
+	snapshot := resourceproviders.NewSnapshot(providers)
+
+	// the Snapshot is used to validate the Locations for each Resource Type, so should never be written without these
+	if v, ok := snapshot.ResourceType("Microsoft.Compute/virtualMachines"); !ok || len(v.Locations) == 0 {
+		return fmt.Errorf("the Snapshot for %q doesn't contain the Locations for `Microsoft.Compute/virtualMachines`", environment)
+	}
+
+	contents, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing the Snapshot: %+v", err)
+	}
+
+	if err := os.WriteFile(outputPath, append(contents, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing the Snapshot to %q: %+v", outputPath, err)
+	}
+
+	return nil
+}
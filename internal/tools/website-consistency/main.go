@@ -0,0 +1,464 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+// probeValue is validated against each string argument to determine the possible values (if any)
+const probeValue = "website-consistency-probe"
+
+var (
+	fieldRegex         = regexp.MustCompile("^\\*\\s+`([a-zA-Z0-9_]+)`\\s*-\\s*(?:\\((Required|Optional)\\))?(.*)$")
+	blockHeaderRegex   = regexp.MustCompile("^(?:An?|The|Each)?[A-Za-z0-9_`, ]*`[a-z0-9_]+`\\s+(?:blocks?\\s+)?(?:supports?|exports?|contains?)\\b")
+	defaultRegex       = regexp.MustCompile("(?i)defaults? to `([^`]*)`")
+	backtickRegex      = regexp.MustCompile("`([^`]+)`")
+	possibleValueRegex = regexp.MustCompile(`to be one of \[(.*)\], got ` + probeValue)
+
+	// fieldsToSkip are fields which are documented in the Provider documentation, rather than on each page
+	fieldsToSkip = map[string]struct{}{
+		"tags_all": {},
+		"timeouts": {},
+	}
+)
+
+func main() {
+	f := flag.NewFlagSet("website-consistency", flag.ExitOnError)
+
+	websitePath := f.String("website-path", "", "The relative path to the website folder")
+	exceptionsPath := f.String("exceptions", "", "The relative path to a file containing known issues which should be ignored")
+	resourceName := f.String("name", "", "Only check the Data Source/Resource with this name")
+	updateExceptions := f.Bool("update-exceptions", false, "Write any issues which are found into the exceptions file, rather than failing")
+
+	_ = f.Parse(os.Args[1:])
+
+	// the provider logs when building the schema, which isn't relevant here
+	log.SetOutput(io.Discard)
+	logger := log.New(os.Stderr, "", 0)
+
+	if *websitePath == "" {
+		logger.Print("The Relative Website Path must be specified via `-website-path`")
+		os.Exit(1)
+	}
+
+	p := provider.AzureProvider()
+	issues := make([]string, 0)
+	for _, v := range []struct {
+		kind      string
+		directory string
+		resources map[string]*schema.Resource
+	}{
+		{kind: "Data Source", directory: "d", resources: p.DataSourcesMap},
+		{kind: "Resource", directory: "r", resources: p.ResourcesMap},
+	} {
+		for name, resource := range v.resources {
+			if *resourceName != "" && name != *resourceName {
+				continue
+			}
+
+			docPath := filepath.Join(*websitePath, "docs", v.directory, fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(name, "azurerm_")))
+			page, err := parsePage(docPath)
+			if err != nil {
+				issues = append(issues, fmt.Sprintf("%s %s: %+v", v.kind, name, err))
+				continue
+			}
+
+			for _, issue := range checkResource(resource, page) {
+				issues = append(issues, fmt.Sprintf("%s %s: %s", v.kind, name, issue))
+			}
+		}
+	}
+	sort.Strings(issues)
+
+	if *updateExceptions {
+		if *exceptionsPath == "" {
+			logger.Print("The path to the exceptions file must be specified via `-exceptions` when using `-update-exceptions`")
+			os.Exit(1)
+		}
+		if err := os.WriteFile(*exceptionsPath, []byte(strings.Join(issues, "\n")+"\n"), 0o644); err != nil {
+			logger.Printf("writing exceptions to %q: %+v", *exceptionsPath, err)
+			os.Exit(1)
+		}
+		logger.Printf("Wrote %d issues to %q", len(issues), *exceptionsPath)
+		return
+	}
+
+	exceptions := map[string]struct{}{}
+	if *exceptionsPath != "" {
+		contents, err := os.ReadFile(*exceptionsPath)
+		if err != nil {
+			logger.Printf("reading exceptions from %q: %+v", *exceptionsPath, err)
+			os.Exit(1)
+		}
+		for _, line := range strings.Split(string(contents), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				exceptions[line] = struct{}{}
+			}
+		}
+	}
+
+	found := 0
+	for _, issue := range issues {
+		if _, ok := exceptions[issue]; ok {
+			delete(exceptions, issue)
+			continue
+		}
+		logger.Print(issue)
+		found++
+	}
+
+	if found > 0 {
+		logger.Printf("\nFound %d differences between the Schema and the Documentation", found)
+		os.Exit(1)
+	}
+
+	// exceptions which have been fixed should be removed, so that these don't regress
+	if len(exceptions) > 0 && *resourceName == "" {
+		fixed := make([]string, 0, len(exceptions))
+		for k := range exceptions {
+			fixed = append(fixed, k)
+		}
+		sort.Strings(fixed)
+		logger.Printf("The following issues have been fixed and should be removed from %q:\n\n%s", *exceptionsPath, strings.Join(fixed, "\n"))
+		os.Exit(1)
+	}
+}
+
+// docField is a field documented within the Arguments or Attributes Reference
+type docField struct {
+	name     string
+	required bool
+	optional bool
+	text     string
+}
+
+// docSection contains the fields documented within a section of the documentation, keyed by the block
+// they're documented within - where the top-level fields use an empty key
+type docSection map[string]map[string]*docField
+
+type docPage struct {
+	arguments  docSection
+	attributes docSection
+}
+
+func parsePage(path string) (*docPage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the documentation %q doesn't exist", path)
+		}
+		return nil, fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	page := docPage{
+		arguments:  docSection{},
+		attributes: docSection{},
+	}
+
+	var section docSection
+	var field *docField
+	blocks := []string{""}
+	inCodeBlock := false
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+
+		if strings.HasPrefix(line, "## ") {
+			field = nil
+			blocks = []string{""}
+			switch strings.TrimSpace(strings.TrimPrefix(line, "## ")) {
+			case "Argument Reference", "Arguments Reference":
+				section = page.arguments
+			case "Attributes Reference", "Attribute Reference":
+				section = page.attributes
+			default:
+				section = nil
+			}
+			continue
+		}
+		if section == nil {
+			continue
+		}
+
+		if match := fieldRegex.FindStringSubmatch(line); match != nil {
+			field = &docField{
+				name:     match[1],
+				required: match[2] == "Required",
+				optional: match[2] == "Optional",
+				text:     match[3],
+			}
+			// a field can be documented once for multiple blocks which share the same schema
+			for _, block := range blocks {
+				if section[block] == nil {
+					section[block] = map[string]*docField{}
+				}
+				section[block][field.name] = field
+			}
+			continue
+		}
+
+		if header := blockHeaderRegex.FindString(line); header != "" && strings.HasSuffix(line, ":") {
+			blocks = make([]string, 0)
+			for _, name := range backtickRegex.FindAllStringSubmatch(header, -1) {
+				blocks = append(blocks, name[1])
+			}
+			field = nil
+			continue
+		}
+
+		if line == "---" {
+			field = nil
+			continue
+		}
+
+		// notes (and any other text) following a field are considered part of the description for that field
+		if field != nil && line != "" {
+			field.text += " " + line
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	return &page, nil
+}
+
+// schemaBlocks returns the fields within the schema keyed by the name of the block they're within, where the
+// top-level fields use an empty key - since nested blocks are documented by name, multiple blocks can share a key
+func schemaBlocks(input map[string]*schema.Schema) map[string][]map[string]*schema.Schema {
+	output := map[string][]map[string]*schema.Schema{
+		"": {input},
+	}
+
+	var walk func(fields map[string]*schema.Schema)
+	walk = func(fields map[string]*schema.Schema) {
+		for k, v := range fields {
+			if nested, ok := v.Elem.(*schema.Resource); ok {
+				output[k] = append(output[k], nested.Schema)
+				walk(nested.Schema)
+			}
+		}
+	}
+	walk(input)
+
+	return output
+}
+
+func checkResource(resource *schema.Resource, page *docPage) []string {
+	issues := make([]string, 0)
+	blocks := schemaBlocks(resource.Schema)
+
+	for blockName, schemas := range blocks {
+		for _, fields := range schemas {
+			for name, s := range fields {
+				if _, ok := fieldsToSkip[name]; ok {
+					continue
+				}
+
+				path := name
+				if blockName != "" {
+					path = fmt.Sprintf("%s.%s", blockName, name)
+				}
+				issues = append(issues, checkField(path, blockName, name, s, page)...)
+			}
+		}
+	}
+
+	// then check for fields which are documented but don't exist
+	for _, v := range []struct {
+		name    string
+		section docSection
+	}{
+		{name: "Arguments", section: page.arguments},
+		{name: "Attributes", section: page.attributes},
+	} {
+		for blockName, fields := range v.section {
+			schemas, ok := blocks[blockName]
+			if !ok {
+				issues = append(issues, fmt.Sprintf("the block `%s` is documented in the %s Reference but doesn't exist in the Schema", blockName, v.name))
+				continue
+			}
+
+			for name := range fields {
+				if blockName == "" && name == "id" {
+					continue
+				}
+				if _, ok := fieldsToSkip[name]; ok {
+					continue
+				}
+
+				exists := false
+				for _, schemaFields := range schemas {
+					if _, ok := schemaFields[name]; ok {
+						exists = true
+						break
+					}
+				}
+				if !exists {
+					issues = append(issues, fmt.Sprintf("the field `%s` is documented in the %s Reference but doesn't exist in the Schema", fieldPath(blockName, name), v.name))
+				}
+			}
+		}
+	}
+
+	sort.Strings(issues)
+	return uniqueIssues(issues)
+}
+
+func checkField(path, blockName, name string, s *schema.Schema, page *docPage) []string {
+	isArgument := s.Required || s.Optional
+
+	argument := page.arguments[blockName][name]
+	attribute := page.attributes[blockName][name]
+
+	if !isArgument {
+		if argument != nil && (argument.required || argument.optional) {
+			return []string{fmt.Sprintf("the field `%s` is Computed but is documented as an Argument", path)}
+		}
+		if argument == nil && attribute == nil {
+			return []string{fmt.Sprintf("the attribute `%s` is not documented", path)}
+		}
+		return nil
+	}
+
+	// deprecated fields don't need to be documented, but should be correct when they are
+	if argument == nil {
+		if s.Deprecated == "" {
+			return []string{fmt.Sprintf("the argument `%s` is not documented", path)}
+		}
+		return nil
+	}
+
+	issues := make([]string, 0)
+	if s.Required && argument.optional {
+		issues = append(issues, fmt.Sprintf("the argument `%s` is Required but is documented as Optional", path))
+	}
+	if s.Optional && argument.required {
+		issues = append(issues, fmt.Sprintf("the argument `%s` is Optional but is documented as Required", path))
+	}
+
+	documentsForceNew := strings.Contains(strings.ToLower(argument.text), "forces a new")
+	if s.ForceNew && !documentsForceNew {
+		issues = append(issues, fmt.Sprintf("the argument `%s` is ForceNew but this is not documented", path))
+	}
+	if !s.ForceNew && documentsForceNew {
+		issues = append(issues, fmt.Sprintf("the argument `%s` is documented as ForceNew but isn't", path))
+	}
+
+	if s.Default != nil {
+		expected := fmt.Sprintf("%v", s.Default)
+		if match := defaultRegex.FindStringSubmatch(argument.text); match == nil {
+			issues = append(issues, fmt.Sprintf("the argument `%s` defaults to `%s` but this is not documented", path, expected))
+		} else if match[1] != expected {
+			issues = append(issues, fmt.Sprintf("the argument `%s` defaults to `%s` but is documented as defaulting to `%s`", path, expected, match[1]))
+		}
+	}
+
+	// the casing of possible values isn't checked, since these are commonly validated case-insensitively
+	documented := map[string]struct{}{}
+	for _, match := range backtickRegex.FindAllStringSubmatch(argument.text, -1) {
+		documented[strings.ToLower(match[1])] = struct{}{}
+	}
+	for _, value := range possibleValues(s) {
+		if _, ok := documented[strings.ToLower(value)]; !ok {
+			issues = append(issues, fmt.Sprintf("the argument `%s` supports the value `%s` but this is not documented", path, value))
+		}
+	}
+
+	return issues
+}
+
+// possibleValues returns the values which a string argument (or list/set of strings) supports when these are
+// validated using `validation.StringInSlice`, which is determined from the error returned for an invalid value
+func possibleValues(s *schema.Schema) []string {
+	validateFunc := s.ValidateFunc
+	if s.Type == schema.TypeList || s.Type == schema.TypeSet {
+		if elem, ok := s.Elem.(*schema.Schema); ok {
+			validateFunc = elem.ValidateFunc
+		}
+	}
+	if validateFunc == nil {
+		return nil
+	}
+
+	validate := func(value string) (errors []error) {
+		// validation functions for other types may not expect a string
+		defer func() {
+			if r := recover(); r != nil {
+				errors = []error{fmt.Errorf("%+v", r)}
+			}
+		}()
+		_, errors = validateFunc(value, "value")
+		return errors
+	}
+
+	var match []string
+	for _, err := range validate(probeValue) {
+		if match = possibleValueRegex.FindStringSubmatch(err.Error()); match != nil {
+			break
+		}
+	}
+	if match == nil {
+		return nil
+	}
+
+	// the values are formatted using `%v` so are space separated - as such values containing spaces are
+	// found by joining words until the value is valid
+	output := make([]string, 0)
+	current := ""
+	for _, word := range strings.Split(match[1], " ") {
+		if current == "" {
+			current = word
+		} else {
+			current = fmt.Sprintf("%s %s", current, word)
+		}
+
+		if len(validate(current)) == 0 {
+			output = append(output, current)
+			current = ""
+		}
+	}
+
+	return output
+}
+
+func fieldPath(blockName, name string) string {
+	if blockName == "" {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", blockName, name)
+}
+
+func uniqueIssues(input []string) []string {
+	output := make([]string, 0, len(input))
+	for i, v := range input {
+		if i > 0 && input[i-1] == v {
+			continue
+		}
+		output = append(output, v)
+	}
+	return output
+}
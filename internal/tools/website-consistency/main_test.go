@@ -0,0 +1,229 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const testDocumentation = "---\n" +
+	"subcategory: \"Example\"\n" +
+	"---\n" +
+	"\n" +
+	"# azurerm_example\n" +
+	"\n" +
+	"## Example Usage\n" +
+	"\n" +
+	"```hcl\n" +
+	"* `not_a_field` - (Required) This is within a code block.\n" +
+	"```\n" +
+	"\n" +
+	"## Argument Reference\n" +
+	"\n" +
+	"* `name` - (Required) The name of the Example. Changing this forces a new Example to be created.\n" +
+	"\n" +
+	"* `sku` - (Optional) The SKU of the Example. Possible values are `Basic` and `standard`.\n" +
+	"\n" +
+	"-> **NOTE:** Defaults to `Basic`.\n" +
+	"\n" +
+	"* `enabled` - (Required) Should the Example be enabled?\n" +
+	"\n" +
+	"* `primary` - (Optional) A `primary` block as defined below.\n" +
+	"\n" +
+	"* `secondary` - (Optional) A `secondary` block as defined below.\n" +
+	"\n" +
+	"* `removed` - (Optional) This no longer exists.\n" +
+	"\n" +
+	"---\n" +
+	"\n" +
+	"A `primary`, `secondary` block supports the following:\n" +
+	"\n" +
+	"* `port` - (Optional) The port. Defaults to `80`.\n" +
+	"\n" +
+	"## Attributes Reference\n" +
+	"\n" +
+	"* `id` - The ID of the Example.\n"
+
+func testResource() *schema.Resource {
+	endpoint := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  443,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sku": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium Plus"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"primary": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     endpoint,
+			},
+			"secondary": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     endpoint,
+			},
+			"undocumented": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"deprecated": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "this has been deprecated",
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "example.html.markdown")
+	if err := os.WriteFile(path, []byte(testDocumentation), 0o644); err != nil {
+		t.Fatalf("writing documentation: %+v", err)
+	}
+
+	page, err := parsePage(path)
+	if err != nil {
+		t.Fatalf("parsing documentation: %+v", err)
+	}
+
+	if _, ok := page.arguments[""]["not_a_field"]; ok {
+		t.Fatalf("expected fields within code blocks to be ignored")
+	}
+	if name := page.arguments[""]["name"]; name == nil || !name.required {
+		t.Fatalf("expected `name` to be documented as Required but got %+v", name)
+	}
+	if sku := page.arguments[""]["sku"]; sku == nil || !sku.optional || !defaultRegex.MatchString(sku.text) {
+		t.Fatalf("expected `sku` to be documented as Optional including the note but got %+v", sku)
+	}
+	for _, block := range []string{"primary", "secondary"} {
+		if _, ok := page.arguments[block]["port"]; !ok {
+			t.Fatalf("expected `port` to be documented within the `%s` block", block)
+		}
+	}
+	if _, ok := page.attributes[""]["id"]; !ok {
+		t.Fatalf("expected `id` to be documented as an Attribute")
+	}
+}
+
+func TestParsePageMissing(t *testing.T) {
+	if _, err := parsePage(filepath.Join(t.TempDir(), "missing.html.markdown")); err == nil {
+		t.Fatalf("expected an error for missing documentation")
+	}
+}
+
+func TestCheckResource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "example.html.markdown")
+	if err := os.WriteFile(path, []byte(testDocumentation), 0o644); err != nil {
+		t.Fatalf("writing documentation: %+v", err)
+	}
+	page, err := parsePage(path)
+	if err != nil {
+		t.Fatalf("parsing documentation: %+v", err)
+	}
+
+	expected := []string{
+		"the argument `enabled` is Optional but is documented as Required",
+		"the argument `primary.port` defaults to `443` but is documented as defaulting to `80`",
+		"the argument `secondary.port` defaults to `443` but is documented as defaulting to `80`",
+		"the argument `sku` supports the value `Premium Plus` but this is not documented",
+		"the argument `undocumented` is not documented",
+		"the attribute `endpoint` is not documented",
+		"the field `removed` is documented in the Arguments Reference but doesn't exist in the Schema",
+	}
+	actual := checkResource(testResource(), page)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected:\n\n%+v\n\nbut got:\n\n%+v", expected, actual)
+	}
+}
+
+func TestPossibleValues(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    *schema.Schema
+		expected []string
+	}{
+		{
+			name: "No Validation",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		{
+			name: "Other Validation",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		{
+			name: "Non-String Validation",
+			input: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+		},
+		{
+			name: "String In Slice",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"First", "Second Value", "Third"}, false),
+			},
+			expected: []string{"First", "Second Value", "Third"},
+		},
+		{
+			name: "Set of Strings",
+			input: &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"First", "Second"}, true),
+				},
+			},
+			expected: []string{"First", "Second"},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := possibleValues(v.input)
+		if len(actual) == 0 && len(v.expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
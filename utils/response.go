@@ -25,6 +25,10 @@ func ResponseWasConflict(resp autorest.Response) bool {
 
 func ResponseErrorIsRetryable(err error) bool {
 	if arerr, ok := err.(autorest.DetailedError); ok {
+		// requests which have been throttled can be retried once the `Retry-After` duration has elapsed
+		if arerr.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
 		err = arerr.Original
 	}
 
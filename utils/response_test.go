@@ -66,6 +66,10 @@ func TestResponseErrorIsRetryable(t *testing.T) {
 		{"Unhandled error nested in autorest.DetailedError is not retryable", autorest.DetailedError{
 			Original: fmt.Errorf("Some other error"),
 		}, false},
+		{"Throttled requests are retryable", autorest.DetailedError{
+			Original:   fmt.Errorf("Too Many Requests"),
+			StatusCode: http.StatusTooManyRequests,
+		}, true},
 		{"nil is handled as non-retryable", nil, false},
 	}
 